@@ -0,0 +1,489 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// noPassword is returned when a password is checked for an account which
+// only signs in with OpenID Connect. It is not a failed login.
+func noPassword() error {
+	return failedPrecondition("password", "the account has no password, it signs in with OpenID Connect")
+}
+
+// checkPassword reports whether pass is the password of account. Accounts
+// without a password fail with noPassword.
+func checkPassword(ctx context.Context, tx *sql.Tx, account string, pass string) (bool, error) {
+	var has, valid bool
+	err := tx.QueryRowContext(ctx, "SELECT password IS NOT NULL, COALESCE(password = crypt($1,password), false) FROM accounts WHERE id = $2;", pass, account).Scan(&has, &valid)
+	if err == nil && !has {
+		return false, noPassword()
+	}
+	return valid, err
+}
+
+// reauthenticate confirms that the caller is the owner of account before a
+// destructive change. Accounts with a password need it, and the one-time
+// password if two-factor authentication is enabled. Accounts without a
+// password need a fresh ID token of a linked identity or the one-time
+// password. Wrong passwords and codes count as failed logins.
+func reauthenticate(ctx context.Context, tx *sql.Tx, account string, email string, pass string, otp string, idToken string) error {
+	var has, totp bool
+	err := tx.QueryRowContext(ctx, "SELECT password IS NOT NULL, totp_enabled FROM accounts WHERE id = $1;", account).Scan(&has, &totp)
+	if err != nil {
+		return err
+	}
+	addr := peerAddr(ctx)
+	if err := checkLoginLocked(ctx, email, addr); err != nil {
+		return err
+	}
+	switch {
+	case has:
+		if pass == "" {
+			return badRequest("password", errors.New("password is required"))
+		}
+		valid, err := checkPassword(ctx, tx, account, pass)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			loginFailed(ctx, email, addr)
+			return unauthenticated("password is incorrect")
+		}
+	case idToken != "":
+		if err := checkOIDCLogin(ctx, tx, account, idToken); err != nil {
+			return err
+		}
+	case !totp || otp == "":
+		return failedPrecondition("password", "the account has no password, send a fresh idToken or the otp")
+	}
+	err = verifyLogin(ctx, tx, account, otp)
+	if otp != "" && status.Code(err) == codes.Unauthenticated {
+		loginFailed(ctx, email, addr)
+	}
+	return err
+}
+
+// removeDomain deletes a zone and its records.
+func removeDomain(ctx context.Context, tx *sql.Tx, id string) error {
+	_, err := tx.ExecContext(ctx, "DELETE FROM records WHERE domain_id = $1;", id)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM domains WHERE id = $1;", id)
+	return err
+}
+
+// queryIDs returns the first column of every row of query.
+func queryIDs(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var li []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		li = append(li, id)
+	}
+	return li, rows.Err()
+}
+
+// releaseOrganizations hands the organization zones created by account to
+// another owner. Organizations where account is the only member are
+// deleted with their zones, and ones it is the last owner of with other
+// members left block the deletion.
+func releaseOrganizations(ctx context.Context, tx *sql.Tx, account string) error {
+	orgs, err := queryIDs(ctx, tx, "SELECT organization FROM members WHERE account = $1 AND role = $2;", account, pb.Role_Owner.String())
+	if err != nil {
+		return err
+	}
+	for _, org := range orgs {
+		var owners, members int
+		err = tx.QueryRowContext(ctx, "SELECT count(*) FILTER (WHERE role = $2), count(*) FROM members WHERE organization = $1 AND account <> $3;", org, pb.Role_Owner.String(), account).Scan(&owners, &members)
+		if err != nil {
+			return err
+		}
+		if owners > 0 {
+			continue
+		}
+		if members > 0 {
+			return failedPrecondition("organization", "make another member an owner of organization "+org+" first")
+		}
+		ids, err := queryIDs(ctx, tx, "SELECT id FROM domains WHERE organization = $1;", org)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if err := removeDomain(ctx, tx, id); err != nil {
+				return err
+			}
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM organizations WHERE id = $1;", org)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE domains d SET account = (
+SELECT m.account FROM members m WHERE m.organization = d.organization AND m.role = $2 AND m.account <> $1 ORDER BY m.account LIMIT 1
+) WHERE d.account = $1 AND d.organization IS NOT NULL;`, account, pb.Role_Owner.String())
+	return err
+}
+
+// DeleteAccount deletes the account with its personal zones. Zones of
+// organizations stay with the organization. Every other table refers to
+// the account with ON DELETE CASCADE, so its keys, certificates,
+// identities, pending zones, shares and transfers go with it. The audit
+// log is kept.
+func (s *server) DeleteAccount(ctx context.Context, in *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	info, _ := getInfo(ctx)
+	err = reauthenticate(ctx, tx, a, info.Subject, in.GetPassword(), in.GetOtp(), in.GetIdToken())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = releaseOrganizations(ctx, tx, a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	ids, err := queryIDs(ctx, tx, "SELECT id FROM domains WHERE account = $1 AND organization IS NULL;", a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	for _, id := range ids {
+		if err := removeDomain(ctx, tx, id); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM login_attempts WHERE kind IN ($1,$2) AND subject = $3;", attemptEmail, attemptResetEmail, info.Subject)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM accounts WHERE id = $1;", a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("account deleted", zap.String("account", a), zap.Int("zones", len(ids)))
+	return &pb.DeleteAccountResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
+type exportedAccount struct {
+	Email              string                  `json:"email"`
+	EmailVerified      bool                    `json:"email_verified"`
+	TOTPEnabled        bool                    `json:"totp_enabled"`
+	Admin              bool                    `json:"admin"`
+	SuspendedAt        *time.Time              `json:"suspended_at"`
+	SuspendedReason    string                  `json:"suspended_reason,omitempty"`
+	APIKeys            []exportedAPIKey        `json:"api_keys"`
+	ClientCertificates []exportedCertificate   `json:"client_certificates"`
+	Identities         []exportedIdentity      `json:"oidc_identities"`
+	Organizations      []exportedOrganization  `json:"organizations"`
+	Zones              []exportedZone          `json:"zones"`
+	PendingZones       []exportedPendingDomain `json:"pending_zones"`
+	Shares             []exportedShare         `json:"shares"`
+	Transfers          []exportedTransfer      `json:"transfers"`
+}
+
+type exportedAPIKey struct {
+	Name       string     `json:"name"`
+	Scope      string     `json:"scope"`
+	Zones      []string   `json:"zones"`
+	Revoked    bool       `json:"revoked"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type exportedCertificate struct {
+	Issuer     string     `json:"issuer"`
+	Subject    string     `json:"subject"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+type exportedIdentity struct {
+	Issuer    string    `json:"issuer"`
+	Subject   string    `json:"subject"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type exportedPendingDomain struct {
+	Name         string    `json:"name"`
+	Organization string    `json:"organization,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type exportedOrganization struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+type exportedZone struct {
+	Name         string           `json:"name"`
+	Organization string           `json:"organization,omitempty"`
+	Records      []exportedRecord `json:"records"`
+}
+
+type exportedRecord struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Content  string `json:"content"`
+	TTL      int64  `json:"ttl"`
+	Disabled bool   `json:"disabled"`
+}
+
+type exportedShare struct {
+	Zone    string   `json:"zone"`
+	Role    string   `json:"role"`
+	Subtree string   `json:"subtree"`
+	Types   []string `json:"types"`
+}
+
+type exportedTransfer struct {
+	Zone        string     `json:"zone"`
+	From        string     `json:"from"`
+	To          string     `json:"to"`
+	State       string     `json:"state"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+func timePtr(t pq.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// exportAccount collects everything stored about account.
+func exportAccount(ctx context.Context, tx *sql.Tx, account string) (*exportedAccount, error) {
+	e := &exportedAccount{
+		APIKeys:            []exportedAPIKey{},
+		ClientCertificates: []exportedCertificate{},
+		Identities:         []exportedIdentity{},
+		Organizations:      []exportedOrganization{},
+		Zones:              []exportedZone{},
+		PendingZones:       []exportedPendingDomain{},
+		Shares:             []exportedShare{},
+		Transfers:          []exportedTransfer{},
+	}
+	var suspended pq.NullTime
+	err := tx.QueryRowContext(ctx, "SELECT email, email_verified, totp_enabled, is_admin, suspended_at, suspended_reason FROM accounts WHERE id = $1;", account).Scan(&e.Email, &e.EmailVerified, &e.TOTPEnabled, &e.Admin, &suspended, &e.SuspendedReason)
+	if err != nil {
+		return nil, err
+	}
+	e.SuspendedAt = timePtr(suspended)
+
+	rows, err := tx.QueryContext(ctx, "SELECT name, scope, zones, revoked, expires_at, last_used_at, created_at FROM api_keys WHERE account = $1 ORDER BY id;", account)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var (
+			k                 exportedAPIKey
+			expires, lastUsed pq.NullTime
+		)
+		if err := rows.Scan(&k.Name, &k.Scope, pq.Array(&k.Zones), &k.Revoked, &expires, &lastUsed, &k.CreatedAt); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		k.ExpiresAt, k.LastUsedAt = timePtr(expires), timePtr(lastUsed)
+		e.APIKeys = append(e.APIKeys, k)
+	}
+	rows.Close()
+
+	rows, err = tx.QueryContext(ctx, "SELECT issuer, subject, name, created_at, last_used_at FROM client_certificates WHERE account = $1 ORDER BY created_at;", account)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var (
+			c        exportedCertificate
+			lastUsed pq.NullTime
+		)
+		if err := rows.Scan(&c.Issuer, &c.Subject, &c.Name, &c.CreatedAt, &lastUsed); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		c.LastUsedAt = timePtr(lastUsed)
+		e.ClientCertificates = append(e.ClientCertificates, c)
+	}
+	rows.Close()
+
+	rows, err = tx.QueryContext(ctx, "SELECT issuer, subject, created_at FROM oidc_identities WHERE account = $1 ORDER BY created_at;", account)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var i exportedIdentity
+		if err := rows.Scan(&i.Issuer, &i.Subject, &i.CreatedAt); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		e.Identities = append(e.Identities, i)
+	}
+	rows.Close()
+
+	rows, err = tx.QueryContext(ctx, "SELECT o.name, m.role FROM members m JOIN organizations o ON o.id = m.organization WHERE m.account = $1 ORDER BY o.name;", account)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var o exportedOrganization
+		if err := rows.Scan(&o.Name, &o.Role); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		e.Organizations = append(e.Organizations, o)
+	}
+	rows.Close()
+
+	rows, err = tx.QueryContext(ctx, "SELECT d.id, d.name, o.name FROM domains d LEFT JOIN organizations o ON o.id = d.organization WHERE d.account = $1 ORDER BY d.name;", account)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for rows.Next() {
+		var (
+			id  string
+			z   exportedZone
+			org sql.NullString
+		)
+		if err := rows.Scan(&id, &z.Name, &org); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		z.Organization = org.String
+		z.Records = []exportedRecord{}
+		ids = append(ids, id)
+		e.Zones = append(e.Zones, z)
+	}
+	rows.Close()
+	for i, id := range ids {
+		rows, err = tx.QueryContext(ctx, "SELECT name, type, content, COALESCE(ttl, 0), COALESCE(disabled, false) FROM records WHERE domain_id = $1 ORDER BY name, type, content;", id)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var r exportedRecord
+			if err := rows.Scan(&r.Name, &r.Type, &r.Content, &r.TTL, &r.Disabled); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			e.Zones[i].Records = append(e.Zones[i].Records, r)
+		}
+		rows.Close()
+	}
+
+	rows, err = tx.QueryContext(ctx, "SELECT p.name, o.name, p.expires_at, p.created_at FROM pending_domains p LEFT JOIN organizations o ON o.id = p.organization WHERE p.account = $1 ORDER BY p.name;", account)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var (
+			p   exportedPendingDomain
+			org sql.NullString
+		)
+		if err := rows.Scan(&p.Name, &org, &p.ExpiresAt, &p.CreatedAt); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		p.Organization = org.String
+		e.PendingZones = append(e.PendingZones, p)
+	}
+	rows.Close()
+
+	rows, err = tx.QueryContext(ctx, "SELECT d.name, s.role, s.subtree, s.types FROM zone_shares s JOIN domains d ON d.id = s.domain WHERE s.account = $1 ORDER BY d.name;", account)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var s exportedShare
+		if err := rows.Scan(&s.Zone, &s.Role, &s.Subtree, pq.Array(&s.Types)); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		e.Shares = append(e.Shares, s)
+	}
+	rows.Close()
+
+	rows, err = tx.QueryContext(ctx, "SELECT t.name, f.email, r.email, t.state, t.created_at, t.completed_at FROM zone_transfers t JOIN accounts f ON f.id = t.from_account JOIN accounts r ON r.id = t.to_account WHERE t.from_account = $1 OR t.to_account = $1 ORDER BY t.id;", account)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var (
+			t         exportedTransfer
+			completed pq.NullTime
+		)
+		if err := rows.Scan(&t.Zone, &t.From, &t.To, &t.State, &t.CreatedAt, &completed); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		t.CompletedAt = timePtr(completed)
+		e.Transfers = append(e.Transfers, t)
+	}
+	rows.Close()
+	return e, nil
+}
+
+// ExportAccountData returns everything stored about the account as JSON.
+// Password hashes, TOTP secrets and token hashes are left out.
+func (s *server) ExportAccountData(ctx context.Context, in *empty.Empty) (*pb.ExportAccountDataResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	e, err := exportAccount(ctx, tx, a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportAccountDataResponse{Status: pb.ResponseStatus_Ok, Data: b}, nil
+}
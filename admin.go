@@ -0,0 +1,416 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultAdminPageSize = 50
+	maxAdminPageSize     = 500
+)
+
+type adminServer struct{}
+
+// readOnly is returned for mutating calls made with an impersonation
+// token.
+func readOnly() error {
+	return status.Error(codes.PermissionDenied, "impersonation is read-only")
+}
+
+// accountSuspended is returned for mutating calls of suspended accounts.
+func accountSuspended() error {
+	return failedPrecondition("account", "the account is suspended")
+}
+
+// AuthFuncOverride requires a token with the admin claim. Impersonation
+// tokens, API keys and client certificates are refused.
+func (s *adminServer) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
+	if _, err := GetAPIKey(ctx); err == nil {
+		return nil, permissionDenied("server", "admin")
+	}
+	if _, err := GetToken(ctx); err != nil {
+		return nil, unauthenticated("token is required")
+	}
+	ctx, err := AuthHandler(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := getInfo(ctx)
+	if err != nil {
+		return nil, unauthenticated(err.Error())
+	}
+	if !info.Admin || info.Impersonator != "" {
+		return nil, permissionDenied("server", "admin")
+	}
+	return ctx, nil
+}
+
+// beginAdmin starts a transaction and checks in the database that the
+// caller is still an administrator, as the claim lives until the token
+// expires.
+func beginAdmin(ctx context.Context) (*sql.Tx, string, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, "", err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+	err = requireAdmin(ctx, tx, a)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+	return tx, a, nil
+}
+
+// pageSize returns the number of rows to return for a requested limit.
+func pageSize(limit int32) int32 {
+	if limit <= 0 {
+		return defaultAdminPageSize
+	}
+	if limit > maxAdminPageSize {
+		return maxAdminPageSize
+	}
+	return limit
+}
+
+// lookupAccount returns the id of the account with email.
+func lookupAccount(ctx context.Context, tx *sql.Tx, email string) (string, error) {
+	var id string
+	err := tx.QueryRowContext(ctx, "SELECT id FROM accounts WHERE email = $1;", email).Scan(&id)
+	if err == sql.ErrNoRows {
+		return "", notFound("account", email)
+	}
+	return id, err
+}
+
+// lookupZone returns the id of the zone named domain.
+func lookupZone(ctx context.Context, tx *sql.Tx, domain string) (string, error) {
+	var id string
+	err := tx.QueryRowContext(ctx, "SELECT id FROM domains WHERE name = $1;", domain).Scan(&id)
+	if err == sql.ErrNoRows {
+		return "", notFound("domain", domain)
+	}
+	return id, err
+}
+
+// ListAccounts returns the accounts whose email contains the query.
+func (s *adminServer) ListAccounts(ctx context.Context, in *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	if in.GetOffset() < 0 {
+		return nil, badRequest("offset", errors.New("offset must not be negative"))
+	}
+	tx, _, err := beginAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.QueryContext(ctx, `SELECT a.id, a.email, a.email_verified, a.is_admin, a.totp_enabled, a.suspended_at, a.suspended_reason,
+(SELECT count(*) FROM domains d WHERE d.account = a.id)
+FROM accounts a WHERE a.email ILIKE '%' || $1 || '%' ORDER BY a.id LIMIT $2 OFFSET $3;`,
+		in.GetQuery(), pageSize(in.GetLimit()), in.GetOffset())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	li := make([]*pb.AccountSummary, 0, 8)
+	for rows.Next() {
+		var (
+			item      = new(pb.AccountSummary)
+			suspended pq.NullTime
+		)
+		err = rows.Scan(&item.Id, &item.Email, &item.EmailVerified, &item.Admin, &item.TotpEnabled, &suspended, &item.SuspendedReason, &item.Zones)
+		if err != nil {
+			rows.Close()
+			tx.Rollback()
+			return nil, err
+		}
+		item.SuspendedAt = unixTime(suspended)
+		li = append(li, item)
+	}
+	rows.Close()
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListAccountsResponse{Status: pb.ResponseStatus_Ok, Accounts: li}, nil
+}
+
+// ListZones returns the zones whose name contains the query, optionally
+// only the ones owned by an account.
+func (s *adminServer) ListZones(ctx context.Context, in *pb.ListZonesRequest) (*pb.ListZonesResponse, error) {
+	if in.GetOffset() < 0 {
+		return nil, badRequest("offset", errors.New("offset must not be negative"))
+	}
+	tx, _, err := beginAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.QueryContext(ctx, `SELECT d.id, d.name, COALESCE(a.email, ''), COALESCE(o.name, ''),
+(SELECT count(*) FROM records r WHERE r.domain_id = d.id)
+FROM domains d LEFT JOIN accounts a ON a.id = d.account LEFT JOIN organizations o ON o.id = d.organization
+WHERE d.name ILIKE '%' || $1 || '%' AND ($2 = '' OR a.email = $2) ORDER BY d.id LIMIT $3 OFFSET $4;`,
+		in.GetQuery(), in.GetEmail(), pageSize(in.GetLimit()), in.GetOffset())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	li := make([]*pb.ZoneSummary, 0, 8)
+	for rows.Next() {
+		item := new(pb.ZoneSummary)
+		err = rows.Scan(&item.Id, &item.Name, &item.Owner, &item.Organization, &item.Records)
+		if err != nil {
+			rows.Close()
+			tx.Rollback()
+			return nil, err
+		}
+		li = append(li, item)
+	}
+	rows.Close()
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListZonesResponse{Status: pb.ResponseStatus_Ok, Zones: li}, nil
+}
+
+// Impersonate issues a read-only token of an account, so administrators
+// see what its owner sees.
+func (s *adminServer) Impersonate(ctx context.Context, in *pb.ImpersonateRequest) (*pb.ImpersonateResponse, error) {
+	if in.GetEmail() == "" {
+		return nil, badRequest("email", errors.New("email is required"))
+	}
+	tx, a, err := beginAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var gen int64
+	err = tx.QueryRowContext(ctx, "SELECT token_generation FROM accounts WHERE email = $1;", in.GetEmail()).Scan(&gen)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return nil, notFound("account", in.GetEmail())
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	info, _ := getInfo(ctx)
+	token, err := authInstance.GenerateImpersonationToken(in.GetEmail(), gen, info.Subject)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("account impersonated", zap.String("email", in.GetEmail()), zap.String("by", a))
+	return &pb.ImpersonateResponse{Status: pb.ResponseStatus_Ok, Token: token}, nil
+}
+
+// disableZones disables every record of the personal zones of account,
+// remembering which records were disabled before. Zones of organizations
+// keep resolving, as other members depend on them.
+func disableZones(ctx context.Context, tx *sql.Tx, account string) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO suspended_records(record,account,disabled)
+SELECT r.id, $1, COALESCE(r.disabled, false) FROM records r JOIN domains d ON d.id = r.domain_id WHERE d.account = $1 AND d.organization IS NULL
+ON CONFLICT (record) DO NOTHING;`, account)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE records SET disabled = true WHERE domain_id IN (SELECT id FROM domains WHERE account = $1 AND organization IS NULL);", account)
+	return err
+}
+
+// restoreZones puts back the disabled state the records disabled by
+// disableZones had.
+func restoreZones(ctx context.Context, tx *sql.Tx, account string) error {
+	_, err := tx.ExecContext(ctx, "UPDATE records r SET disabled = s.disabled FROM suspended_records s WHERE s.record = r.id AND s.account = $1;", account)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM suspended_records WHERE account = $1;", account)
+	return err
+}
+
+// restoreZone is restoreZones for the records of a single domain.
+func restoreZone(ctx context.Context, tx *sql.Tx, id string) error {
+	_, err := tx.ExecContext(ctx, "UPDATE records r SET disabled = s.disabled FROM suspended_records s WHERE s.record = r.id AND r.domain_id = $1;", id)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM suspended_records WHERE record IN (SELECT id FROM records WHERE domain_id = $1);", id)
+	return err
+}
+
+// SuspendAccount makes an account read-only, logs it out everywhere and
+// stops its zones from resolving.
+func (s *adminServer) SuspendAccount(ctx context.Context, in *pb.SuspendAccountRequest) (*pb.SuspendAccountResponse, error) {
+	if in.GetEmail() == "" {
+		return nil, badRequest("email", errors.New("email is required"))
+	}
+	tx, a, err := beginAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := lookupAccount(ctx, tx, in.GetEmail())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if id == a {
+		tx.Rollback()
+		return nil, badRequest("email", errors.New("administrators cannot suspend themselves"))
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE accounts SET suspended_at = $1, suspended_reason = $2 WHERE id = $3;", time.Now(), in.GetReason(), id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = revokeTokens(ctx, tx, id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = disableZones(ctx, tx, id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("account suspended", zap.String("email", in.GetEmail()), zap.String("reason", in.GetReason()), zap.String("by", a))
+	return &pb.SuspendAccountResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
+// UnsuspendAccount lifts a suspension and enables the zones again.
+func (s *adminServer) UnsuspendAccount(ctx context.Context, in *pb.UnsuspendAccountRequest) (*pb.UnsuspendAccountResponse, error) {
+	if in.GetEmail() == "" {
+		return nil, badRequest("email", errors.New("email is required"))
+	}
+	tx, a, err := beginAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := lookupAccount(ctx, tx, in.GetEmail())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE accounts SET suspended_at = NULL, suspended_reason = '' WHERE id = $1;", id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = restoreZones(ctx, tx, id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("account unsuspended", zap.String("email", in.GetEmail()), zap.String("by", a))
+	return &pb.UnsuspendAccountResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
+// ForceRemoveZone removes a zone regardless of its owner.
+func (s *adminServer) ForceRemoveZone(ctx context.Context, in *pb.ForceRemoveZoneRequest) (*pb.ForceRemoveZoneResponse, error) {
+	if in.GetDomain() == "" {
+		return nil, badRequest("domain", errors.New("domain is required"))
+	}
+	tx, a, err := beginAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := lookupZone(ctx, tx, in.GetDomain())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = removeDomain(ctx, tx, id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("zone force removed", zap.String("domain", in.GetDomain()), zap.String("by", a))
+	return &pb.ForceRemoveZoneResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
+// ReassignZone makes an account the owner of a zone. The zone leaves its
+// organization, and its shares and pending transfers are dropped. Records
+// disabled by a suspension of the previous owner are restored, and
+// disabled again if the new owner is suspended.
+func (s *adminServer) ReassignZone(ctx context.Context, in *pb.ReassignZoneRequest) (*pb.ReassignZoneResponse, error) {
+	if in.GetDomain() == "" {
+		return nil, badRequest("domain", errors.New("domain is required"))
+	}
+	if in.GetEmail() == "" {
+		return nil, badRequest("email", errors.New("email is required"))
+	}
+	tx, a, err := beginAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := lookupZone(ctx, tx, in.GetDomain())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	to, err := lookupAccount(ctx, tx, in.GetEmail())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE domains SET account = $1, organization = NULL WHERE id = $2;", to, id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM zone_shares WHERE domain = $1;", id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = restoreZone(ctx, tx, id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var suspended bool
+	err = tx.QueryRowContext(ctx, "SELECT suspended_at IS NOT NULL FROM accounts WHERE id = $1;", to).Scan(&suspended)
+	if err == nil && suspended {
+		err = disableZones(ctx, tx, to)
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE zone_transfers SET state = $1, completed_at = now() WHERE domain = $2 AND state = $3;", transferCancelled, id, transferPending)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("zone reassigned", zap.String("domain", in.GetDomain()), zap.String("email", in.GetEmail()), zap.String("by", a))
+	return &pb.ReassignZoneResponse{Status: pb.ResponseStatus_Ok}, nil
+}
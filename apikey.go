@@ -0,0 +1,403 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	jwt "github.com/dgrijalva/jwt-go"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/lib/pq"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const apiKeyPrefix = "pdns_"
+
+const apiKeyContextKey tk = "apikey"
+
+// apiKey is the API key a request was authenticated with.
+type apiKey struct {
+	ID    int64
+	Scope pb.APIKeyScope
+	Zones []string
+}
+
+// accountMethods manage the account and can only be called with a token
+// obtained by password.
+var accountMethods = map[string]bool{
+	"/api.PdnsService/ChangePassword": true,
+	"/api.PdnsService/Logout":         true,
+	"/api.PdnsService/CreateAPIKey":   true,
+	"/api.PdnsService/ListAPIKeys":    true,
+	"/api.PdnsService/RevokeAPIKey":   true,
+
+	"/api.PdnsService/CreateOrganization": true,
+	"/api.PdnsService/GetOrganizations":   true,
+	"/api.PdnsService/GetMembers":         true,
+	"/api.PdnsService/InviteMember":       true,
+	"/api.PdnsService/RemoveMember":       true,
+	"/api.PdnsService/ShareZone":          true,
+	"/api.PdnsService/UnshareZone":        true,
+	"/api.PdnsService/ListZoneShares":     true,
+	"/api.PdnsService/TransferZone":       true,
+	"/api.PdnsService/AcceptZoneTransfer": true,
+	"/api.PdnsService/CancelZoneTransfer": true,
+	"/api.PdnsService/ListZoneTransfers":  true,
+	"/api.PdnsService/EnrollTOTP":         true,
+	"/api.PdnsService/ConfirmTOTP":        true,
+	"/api.PdnsService/DisableTOTP":        true,
+	"/api.PdnsService/ResendVerification": true,
+	"/api.PdnsService/UnlockAccount":      true,
+	"/api.PdnsService/DeleteAccount":      true,
+	"/api.PdnsService/ExportAccountData":  true,
+
+	"/api.PdnsService/RegisterClientCertificate": true,
+	"/api.PdnsService/ListClientCertificates":    true,
+	"/api.PdnsService/RemoveClientCertificate":   true,
+	"/api.PdnsService/ListAuditEvents":           true,
+}
+
+var readMethods = map[string]bool{
+	"/api.PdnsService/GetDomains": true,
+	"/api.PdnsService/GetRecords": true,
+	"/api.PdnsService/ExportZone": true,
+}
+
+// viewMethods change nothing. Impersonated and suspended accounts can
+// only call them.
+var viewMethods = map[string]bool{
+	"/api.PdnsService/Ping":                   true,
+	"/api.PdnsService/GetDomains":             true,
+	"/api.PdnsService/GetRecords":             true,
+	"/api.PdnsService/ExportZone":             true,
+	"/api.PdnsService/GetJWKS":                true,
+	"/api.PdnsService/ListAPIKeys":            true,
+	"/api.PdnsService/GetOrganizations":       true,
+	"/api.PdnsService/GetMembers":             true,
+	"/api.PdnsService/ListZoneShares":         true,
+	"/api.PdnsService/ListZoneTransfers":      true,
+	"/api.PdnsService/ExportAccountData":      true,
+	"/api.PdnsService/ListClientCertificates": true,
+}
+
+const restrictionContextKey tk = "restriction"
+
+// restrict limits the request to viewMethods. err is returned for other
+// methods.
+func restrict(ctx context.Context, err error) context.Context {
+	return context.WithValue(ctx, restrictionContextKey, err)
+}
+
+var recordMethods = map[string]bool{
+	"/api.PdnsService/AddRecord":    true,
+	"/api.PdnsService/RemoveRecord": true,
+	"/api.PdnsService/UpdateRecord": true,
+	"/api.PdnsService/ApplyChanges": true,
+}
+
+var acmeMethods = map[string]bool{
+	"/api.PdnsService/GetDomains":   true,
+	"/api.PdnsService/AddRecord":    true,
+	"/api.PdnsService/RemoveRecord": true,
+	"/api.PdnsService/ApplyChanges": true,
+}
+
+// GetAPIKey gets api key from context
+func GetAPIKey(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("apikey")) != 1 {
+		return "", errors.New("cannot get metadata from incoming context")
+	}
+	return md.Get("apikey")[0], nil
+}
+
+// APIKeyHandler verifies an API key and stores the account and the key in
+// the context.
+func APIKeyHandler(ctx context.Context, secret string) (context.Context, error) {
+	var (
+		key       apiKey
+		scope     string
+		email     string
+		suspended bool
+	)
+	err := GetDB().QueryRowContext(ctx, "SELECT k.id, k.scope, k.zones, a.email, a.suspended_at IS NOT NULL FROM api_keys k JOIN accounts a ON a.id = k.account WHERE k.key_hash = $1 AND NOT k.revoked AND (k.expires_at IS NULL OR k.expires_at > now());",
+		hashToken(secret)).Scan(&key.ID, &scope, pq.Array(&key.Zones), &email, &suspended)
+	if err == sql.ErrNoRows {
+		return nil, unauthenticated("api key is invalid")
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	key.Scope = pb.APIKeyScope(pb.APIKeyScope_value[scope])
+	_, err = GetDB().ExecContext(ctx, "UPDATE api_keys SET last_used_at = now() WHERE id = $1;", key.ID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	info := &JwtInfo{StandardClaims: jwt.StandardClaims{Subject: email}}
+	ctx = context.WithValue(ctx, k, info)
+	if suspended {
+		ctx = restrict(ctx, accountSuspended())
+	}
+	return context.WithValue(ctx, apiKeyContextKey, &key), nil
+}
+
+// allowMethod reports whether the scope of the key permits method.
+func (key *apiKey) allowMethod(method string) bool {
+	if accountMethods[method] {
+		return false
+	}
+	switch key.Scope {
+	case pb.APIKeyScope_Full:
+		return true
+	case pb.APIKeyScope_ReadOnly:
+		return readMethods[method]
+	case pb.APIKeyScope_RecordsOnly:
+		return readMethods[method] || recordMethods[method]
+	case pb.APIKeyScope_AcmeTXT:
+		return acmeMethods[method]
+	}
+	return false
+}
+
+func (key *apiKey) allowZone(zone string) bool {
+	if len(key.Zones) == 0 {
+		return true
+	}
+	for _, z := range key.Zones {
+		if z == zone {
+			return true
+		}
+	}
+	return false
+}
+
+// allowRecord restricts AcmeTXT keys to ACME challenge records.
+func (key *apiKey) allowRecord(name string, t pb.RRType) bool {
+	if key.Scope != pb.APIKeyScope_AcmeTXT {
+		return true
+	}
+	return t == pb.RRType_TXT && strings.HasPrefix(name, "_acme-challenge.")
+}
+
+// check returns PermissionDenied unless the key permits req.
+func (key *apiKey) check(method string, req interface{}) error {
+	if !key.allowMethod(method) {
+		return status.Error(codes.PermissionDenied, "api key scope does not permit "+method)
+	}
+	var zone string
+	switch r := req.(type) {
+	case *pb.InitZoneRequest:
+		zone = r.GetDomain()
+	case *pb.RemoveZoneRequest:
+		zone = r.GetDomain()
+	case *pb.ImportZoneRequest:
+		zone = r.GetDomain()
+	case *pb.VerifyZoneRequest:
+		zone = r.GetDomain()
+	case *pb.GetRecordsRequest:
+		zone = r.GetOrigin()
+	case *pb.ExportZoneRequest:
+		zone = r.GetOrigin()
+	case *pb.AddRecordRequest:
+		zone = r.GetOrigin()
+		if !key.allowRecord(r.GetName(), r.GetType()) {
+			return permissionDenied("record", r.GetName())
+		}
+	case *pb.RemoveRecordRequest:
+		zone = r.GetOrigin()
+		if !key.allowRecord(r.GetName(), r.GetType()) {
+			return permissionDenied("record", r.GetName())
+		}
+	case *pb.UpdateRecordRequest:
+		zone = r.GetOrigin()
+	case *pb.ApplyChangesRequest:
+		zone = r.GetOrigin()
+		for _, rr := range r.GetRrsets() {
+			if !key.allowRecord(rr.GetName(), rr.GetType()) {
+				return permissionDenied("record", rr.GetName())
+			}
+		}
+	default:
+		return nil
+	}
+	if !key.allowZone(zone) {
+		return permissionDenied("domain", zone)
+	}
+	return nil
+}
+
+// ScopeHandler enforces the scope of the API key a request was
+// authenticated with, and the restriction of impersonated and suspended
+// accounts. Other requests authenticated by token pass through.
+func ScopeHandler(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err, ok := ctx.Value(restrictionContextKey).(error); ok && !viewMethods[info.FullMethod] {
+		return nil, err
+	}
+	key, ok := ctx.Value(apiKeyContextKey).(*apiKey)
+	if !ok {
+		return handler(ctx, req)
+	}
+	if err := key.check(info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	resp, err := handler(ctx, req)
+	if r, ok := resp.(*pb.GetDomainsResponse); ok && err == nil {
+		li := make([]*pb.Domain, 0, len(r.Domains))
+		for _, d := range r.Domains {
+			if key.allowZone(d.GetName()) {
+				li = append(li, d)
+			}
+		}
+		r.Domains = li
+	}
+	return resp, err
+}
+
+func unixTime(t pq.NullTime) int64 {
+	if !t.Valid {
+		return 0
+	}
+	return t.Time.Unix()
+}
+
+func (s *server) CreateAPIKey(ctx context.Context, in *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	if in.GetName() == "" {
+		return nil, badRequest("name", errors.New("name is required"))
+	}
+	if in.GetScope() == pb.APIKeyScope_Unspecified {
+		return nil, badRequest("scope", errors.New("scope is required"))
+	}
+	if _, ok := pb.APIKeyScope_name[int32(in.GetScope())]; !ok {
+		return nil, badRequest("scope", errors.New("unknown scope"))
+	}
+	if in.GetTtl() < 0 {
+		return nil, badRequest("ttl", errors.New("ttl must not be negative"))
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	zones := make([]string, 0, len(in.GetZones()))
+	for _, z := range in.GetZones() {
+		if _, err := authorizeDomain(ctx, tx, z, a, pb.Role_Viewer); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		zones = append(zones, z)
+	}
+	secret, err := randomString(32)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	secret = apiKeyPrefix + secret
+	var expires pq.NullTime
+	if in.GetTtl() > 0 {
+		expires = pq.NullTime{Time: time.Now().Add(time.Duration(in.GetTtl()) * time.Second), Valid: true}
+	}
+	key := &pb.APIKey{Name: in.GetName(), Scope: in.GetScope(), Zones: zones}
+	var created time.Time
+	err = tx.QueryRowContext(ctx, "INSERT INTO api_keys(account,name,key_hash,scope,zones,expires_at) VALUES ($1,$2,$3,$4,$5,$6) RETURNING id, created_at;",
+		a, in.GetName(), hashToken(secret), in.GetScope().String(), pq.Array(zones), expires).Scan(&key.Id, &created)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	key.CreatedAt = created.Unix()
+	key.ExpiresAt = unixTime(expires)
+	return &pb.CreateAPIKeyResponse{Status: pb.ResponseStatus_Ok, Key: key, Secret: secret}, nil
+}
+
+func (s *server) ListAPIKeys(ctx context.Context, in *empty.Empty) (*pb.ListAPIKeysResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT id, name, scope, zones, expires_at, last_used_at, created_at, revoked FROM api_keys WHERE account = $1 ORDER BY id;", a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	li := make([]*pb.APIKey, 0, 4)
+	for rows.Next() {
+		var (
+			key           pb.APIKey
+			scope         string
+			expires, used pq.NullTime
+			created       time.Time
+		)
+		err = rows.Scan(&key.Id, &key.Name, &scope, pq.Array(&key.Zones), &expires, &used, &created, &key.Revoked)
+		if err != nil {
+			rows.Close()
+			tx.Rollback()
+			return nil, err
+		}
+		key.Scope = pb.APIKeyScope(pb.APIKeyScope_value[scope])
+		key.ExpiresAt = unixTime(expires)
+		key.LastUsedAt = unixTime(used)
+		key.CreatedAt = created.Unix()
+		li = append(li, &key)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListAPIKeysResponse{Status: pb.ResponseStatus_Ok, Keys: li}, nil
+}
+
+func (s *server) RevokeAPIKey(ctx context.Context, in *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	res, err := tx.ExecContext(ctx, "UPDATE api_keys SET revoked = true WHERE id = $1 AND account = $2;", in.GetId(), a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if n == 0 {
+		tx.Rollback()
+		return nil, notFound("api key", strconv.FormatInt(in.GetId(), 10))
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevokeAPIKeyResponse{Status: pb.ResponseStatus_Ok}, nil
+}
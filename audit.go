@@ -0,0 +1,310 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// auditTimeout bounds recording an event. The event is recorded after the
+// call, when the context of the request may already be done.
+const auditTimeout = 5 * time.Second
+
+// redacted replaces the values of secretFields in audit payloads.
+const redacted = "REDACTED"
+
+// secretFields are request fields which are never written to the audit
+// log.
+var secretFields = map[string]bool{
+	"password":     true,
+	"pass":         true,
+	"current":      true,
+	"otp":          true,
+	"code":         true,
+	"token":        true,
+	"refreshToken": true,
+	"idToken":      true,
+	"secret":       true,
+}
+
+// redact replaces the values of secretFields in the JSON object payload,
+// at any depth.
+func redact(payload string) (string, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(payload), &v); err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func redactValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			if secretFields[k] {
+				t[k] = redacted
+			} else {
+				t[k] = redactValue(e)
+			}
+		}
+	case []interface{}:
+		for i, e := range t {
+			t[i] = redactValue(e)
+		}
+	}
+	return v
+}
+
+// unauditedMethods change nothing, so their calls are not recorded. It is
+// kept apart from viewMethods, which limits suspended and impersonated
+// sessions.
+var unauditedMethods = map[string]bool{
+	"/api.PdnsService/Ping":                   true,
+	"/api.PdnsService/GetDomains":             true,
+	"/api.PdnsService/GetRecords":             true,
+	"/api.PdnsService/ExportZone":             true,
+	"/api.PdnsService/GetJWKS":                true,
+	"/api.PdnsService/ListAPIKeys":            true,
+	"/api.PdnsService/GetOrganizations":       true,
+	"/api.PdnsService/GetMembers":             true,
+	"/api.PdnsService/ListZoneShares":         true,
+	"/api.PdnsService/ListZoneTransfers":      true,
+	"/api.PdnsService/ExportAccountData":      true,
+	"/api.PdnsService/ListClientCertificates": true,
+	"/api.PdnsService/ListAuditEvents":        true,
+	"/api.AdminService/ListAccounts":          true,
+	"/api.AdminService/ListZones":             true,
+}
+
+// requestZone returns the zone a request is about, if any.
+func requestZone(req interface{}) string {
+	if r, ok := req.(interface{ GetOrigin() string }); ok {
+		return r.GetOrigin()
+	}
+	if r, ok := req.(interface{ GetDomain() string }); ok {
+		return r.GetDomain()
+	}
+	return ""
+}
+
+// auditSubject carries who made a call. AuditHandler runs before the
+// authentication, so AuthHandler fills it in, and the handlers of public
+// methods set the email they act for.
+type auditSubject struct {
+	email string
+	key   sql.NullInt64
+}
+
+const auditContextKey tk = "audit"
+
+// setAuditEmail records who a call of a public method acted for.
+func setAuditEmail(ctx context.Context, email string) {
+	if s, ok := ctx.Value(auditContextKey).(*auditSubject); ok {
+		s.email = email
+	}
+}
+
+// setAuditCaller records the account and the API key ctx was
+// authenticated with.
+func setAuditCaller(ctx context.Context) {
+	s, ok := ctx.Value(auditContextKey).(*auditSubject)
+	if !ok {
+		return
+	}
+	if info, err := getInfo(ctx); err == nil {
+		s.email = info.Subject
+	}
+	if k, ok := ctx.Value(apiKeyContextKey).(*apiKey); ok && k.ID != 0 {
+		s.key = sql.NullInt64{Int64: k.ID, Valid: true}
+	}
+}
+
+// truncate cuts s to at most n characters, so values taken from a request
+// fit their columns and cannot make the insert fail.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
+
+// zoneHolder identifies a zone and who held it when an event was
+// recorded. Events are only shown to the holder of the same zone, so the
+// history of earlier owners stays hidden after a transfer, a reassign or
+// when a removed name is created again.
+type zoneHolder struct {
+	domain       sql.NullInt64
+	owner        sql.NullInt64
+	organization sql.NullInt64
+}
+
+// lookupHolder returns the holder of the zone named name. It is empty if
+// the zone does not exist.
+func lookupHolder(ctx context.Context, q interface {
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}, name string) (zoneHolder, error) {
+	var h zoneHolder
+	if name == "" {
+		return h, nil
+	}
+	err := q.QueryRowContext(ctx, "SELECT id, account, organization FROM domains WHERE name = $1;", name).Scan(&h.domain, &h.owner, &h.organization)
+	if err == sql.ErrNoRows {
+		return h, nil
+	}
+	return h, err
+}
+
+// AuditHandler records every call of a method which is not in
+// unauditedMethods, whether it succeeded or not, and every call rejected
+// by the authentication. It runs before AuthHandler. The zone is looked
+// up before the call, so removed zones are still attributed, and after it
+// for zones the call created.
+func AuditHandler(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	subject := new(auditSubject)
+	ctx = context.WithValue(ctx, auditContextKey, subject)
+	if unauditedMethods[info.FullMethod] {
+		resp, err := handler(ctx, req)
+		if status.Code(err) == codes.Unauthenticated {
+			recordAudit(ctx, info.FullMethod, req, subject, zoneHolder{}, err)
+		}
+		return resp, err
+	}
+	zone := requestZone(req)
+	h, herr := lookupHolder(ctx, GetDB(), zone)
+	resp, err := handler(ctx, req)
+	if herr == nil && !h.domain.Valid {
+		h, herr = lookupHolder(ctx, GetDB(), zone)
+	}
+	if herr != nil {
+		logger.Error("failed to look up audited zone", zap.String("zone", zone), zap.Error(herr))
+	}
+	recordAudit(ctx, info.FullMethod, req, subject, h, err)
+	return resp, err
+}
+
+// recordAudit writes an audit event. Failures are only logged, as the
+// call has already been made. Calls without authentication are recorded
+// with the email the handler acted for, or else the one in the request.
+func recordAudit(ctx context.Context, method string, req interface{}, subject *auditSubject, h zoneHolder, result error) {
+	var (
+		email   = subject.email
+		payload = "{}"
+	)
+	if r, ok := req.(interface{ GetEmail() string }); ok && email == "" {
+		email = r.GetEmail()
+	}
+	if m, ok := req.(proto.Message); ok {
+		s, err := (&jsonpb.Marshaler{}).MarshalToString(m)
+		if err == nil {
+			s, err = redact(s)
+		}
+		if err != nil {
+			logger.Error("failed to encode audit payload", zap.String("method", method), zap.Error(err))
+			s = "{}"
+		}
+		payload = s
+	}
+	actx, cancel := context.WithTimeout(context.Background(), auditTimeout)
+	defer cancel()
+	_, err := GetDB().ExecContext(actx, "INSERT INTO audit_events(email,api_key,method,zone,domain,owner,organization,payload,code,peer) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10);",
+		truncate(email, 254), subject.key, truncate(method, 128), truncate(requestZone(req), 255), h.domain, h.owner, h.organization,
+		payload, status.Code(result).String(), truncate(peerAddr(ctx), 64))
+	if err != nil {
+		logger.Error("failed to record audit event", zap.String("method", method), zap.Error(err))
+	}
+}
+
+// ListAuditEvents returns recorded calls. Administrators see every event.
+// Other accounts see the events of a zone they administer recorded while
+// it was held by its current owner or organization, or else their own
+// events.
+func (s *server) ListAuditEvents(ctx context.Context, in *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	if in.GetOffset() < 0 {
+		return nil, badRequest("offset", errors.New("offset must not be negative"))
+	}
+	if in.GetSince() < 0 || in.GetUntil() < 0 {
+		return nil, badRequest("since", errors.New("time range must not be negative"))
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var (
+		email = in.GetEmail()
+		h     zoneHolder
+	)
+	if err := requireAdmin(ctx, tx, a); err != nil {
+		info, _ := getInfo(ctx)
+		switch {
+		case in.GetZone() != "":
+			if _, err := authorizeDomain(ctx, tx, in.GetZone(), a, pb.Role_Admin); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			h, err = lookupHolder(ctx, tx, in.GetZone())
+			if err == nil && !h.domain.Valid {
+				err = notFound("domain", in.GetZone())
+			}
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		case email == "" || email == info.Subject:
+			email = info.Subject
+		default:
+			tx.Rollback()
+			return nil, permissionDenied("account", email)
+		}
+	}
+	rows, err := tx.QueryContext(ctx, `SELECT id, email, COALESCE(api_key, 0), method, zone, payload, code, peer, created_at FROM audit_events
+WHERE ($1 = '' OR zone = $1) AND ($2 = '' OR email = $2)
+AND ($3::BIGINT = 0 OR created_at >= to_timestamp($3::BIGINT)) AND ($4::BIGINT = 0 OR created_at < to_timestamp($4::BIGINT))
+AND ($7::INT IS NULL OR (domain = $7 AND (organization = $8 OR ($8::INT IS NULL AND organization IS NULL AND owner = $9))))
+ORDER BY id DESC LIMIT $5 OFFSET $6;`,
+		in.GetZone(), email, in.GetSince(), in.GetUntil(), pageSize(in.GetLimit()), in.GetOffset(), h.domain, h.organization, h.owner)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	li := make([]*pb.AuditEvent, 0, 8)
+	for rows.Next() {
+		var (
+			item    = new(pb.AuditEvent)
+			created time.Time
+		)
+		err = rows.Scan(&item.Id, &item.Email, &item.ApiKey, &item.Method, &item.Zone, &item.Payload, &item.Code, &item.Peer, &created)
+		if err != nil {
+			rows.Close()
+			tx.Rollback()
+			return nil, err
+		}
+		item.CreatedAt = created.Unix()
+		li = append(li, item)
+	}
+	rows.Close()
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListAuditEventsResponse{Status: pb.ResponseStatus_Ok, Events: li}, nil
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	s, err := redact(`{"email":"a@example.com","password":"Change.Me-1","nested":[{"otp":"123456","name":"x"}]}`)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `{"email":"a@example.com","nested":[{"name":"x","otp":"REDACTED"}],"password":"REDACTED"}`, s)
+	_, err = redact("not json")
+	assert.NotEqual(t, nil, err)
+}
+
+func TestRequestZone(t *testing.T) {
+	assert.Equal(t, "example.com", requestZone(&pb.AddRecordRequest{Origin: "example.com"}))
+	assert.Equal(t, "example.com", requestZone(&pb.InitZoneRequest{Domain: "example.com"}))
+	assert.Equal(t, "", requestZone(&pb.CreateAccountRequest{Email: "a@example.com"}))
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "abc", truncate("abc", 3))
+	assert.Equal(t, "ab", truncate("abc", 2))
+	assert.Equal(t, "ドメ", truncate("ドメイン", 2))
+}
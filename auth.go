@@ -1,17 +1,10 @@
 package main
 
 import (
-	"bufio"
 	"context"
-	"crypto/rsa"
-	"crypto/x509"
-	"encoding/base64"
-	"encoding/json"
-	"encoding/pem"
+	"database/sql"
 	"errors"
-	"log"
-	"os"
-	"strings"
+	"sync"
 	"time"
 
 	jwt "github.com/dgrijalva/jwt-go"
@@ -20,91 +13,64 @@ import (
 
 //JWTAuth is keys to generate token
 type JWTAuth struct {
-	PrivateKey *rsa.PrivateKey
-	PublicKey  *rsa.PublicKey
+	mu       sync.RWMutex
+	paths    []string
+	modTimes map[string]time.Time
+	keys     map[string]*signingKey
+	signing  *signingKey
 }
 
 var authInstance *JWTAuth = nil
 
 // InitJWTAuth sets authInstance
-func InitJWTAuth() *JWTAuth {
+func InitJWTAuth() (*JWTAuth, error) {
 	if authInstance == nil {
-		authInstance = &JWTAuth{
-			PrivateKey: getPrivateKey(),
-			PublicKey:  getPublicKey(),
+		auth := &JWTAuth{paths: jwtKeys}
+		if err := auth.Reload(); err != nil {
+			return nil, err
 		}
+		authInstance = auth
 	}
 
-	return authInstance
+	return authInstance, nil
 }
 
-func getPrivateKey() *rsa.PrivateKey {
-	privateKeyFile, err := os.Open("jwtkey.rsa")
-	if err != nil {
-		panic(err)
-	}
-
-	pemfileinfo, _ := privateKeyFile.Stat()
-	var size int64 = pemfileinfo.Size()
-	pembytes := make([]byte, size)
-
-	buffer := bufio.NewReader(privateKeyFile)
-	_, err = buffer.Read(pembytes)
-
-	data, _ := pem.Decode([]byte(pembytes))
-
-	privateKeyFile.Close()
-
-	privateKeyImported, err := x509.ParsePKCS1PrivateKey(data.Bytes)
-
-	if err != nil {
-		panic(err)
-	}
-
-	return privateKeyImported
-}
-
-func getPublicKey() *rsa.PublicKey {
-	publicKeyFile, err := os.Open("jwtkey.rsa.pub")
-	if err != nil {
-		panic(err)
-	}
-
-	pemfileinfo, _ := publicKeyFile.Stat()
-	var size int64 = pemfileinfo.Size()
-	pembytes := make([]byte, size)
-
-	buffer := bufio.NewReader(publicKeyFile)
-	_, err = buffer.Read(pembytes)
-
-	data, _ := pem.Decode([]byte(pembytes))
-
-	publicKeyFile.Close()
-
-	publicKeyImported, err := x509.ParsePKIXPublicKey(data.Bytes)
-
-	if err != nil {
-		panic(err)
+// GenerateJWTToken generates token. gen is the token generation of the
+// account, tokens of older generations are rejected by AuthHandler. admin
+// adds the claim required by AdminService.
+func (auth *JWTAuth) GenerateJWTToken(id string, gen int64, admin bool) (string, error) {
+	claims := jwt.MapClaims{
+		"exp": time.Now().Add(accessTokenTTL).Unix(),
+		"iat": time.Now().Unix(),
+		"sub": id,
+		"gen": gen,
 	}
-
-	rsaPub, ok := publicKeyImported.(*rsa.PublicKey)
-
-	if !ok {
-		panic(err)
+	if admin {
+		claims["adm"] = true
 	}
-
-	return rsaPub
+	return auth.sign(claims)
 }
 
-// GenerateJWTToken generates token
-func (auth *JWTAuth) GenerateJWTToken(id string) (string, error) {
-	token := jwt.New(jwt.SigningMethodRS512)
-	token.Claims = jwt.MapClaims{
-		"exp": time.Now().Add(time.Hour * time.Duration(3600)).Unix(),
+// GenerateImpersonationToken generates a read-only token of the account
+// id for the administrator by.
+func (auth *JWTAuth) GenerateImpersonationToken(id string, gen int64, by string) (string, error) {
+	return auth.sign(jwt.MapClaims{
+		"exp": time.Now().Add(accessTokenTTL).Unix(),
 		"iat": time.Now().Unix(),
 		"sub": id,
-	}
-	tokenString, err := token.SignedString(auth.PrivateKey)
+		"gen": gen,
+		"imp": by,
+	})
+}
+
+func (auth *JWTAuth) sign(claims jwt.MapClaims) (string, error) {
+	auth.mu.RLock()
+	key := auth.signing
+	auth.mu.RUnlock()
+	token := jwt.New(key.Method)
+	token.Header["kid"] = key.ID
+	token.Claims = claims
+	tokenString, err := token.SignedString(key.Private)
 	if err != nil {
 		return "", err
 	}
@@ -132,40 +98,48 @@ func GetToken(ctx context.Context) (string, error) {
 
 // JwtInfo is Claims struct
 type JwtInfo struct {
-	ExpiresAt int64  `json:"exp,omitempty"`
-	IssuedAt  int64  `json:"iat,omitempty"`
-	Subject   string `json:"sub,omitempty"`
+	Generation int64 `json:"gen"`
+	// Admin is set for administrators.
+	Admin bool `json:"adm,omitempty"`
+	// Impersonator is the administrator using a read-only token of the
+	// account.
+	Impersonator string `json:"imp,omitempty"`
 	jwt.StandardClaims
 }
 
-func getInfoFromToken(token string) (*JwtInfo, error) {
-	s := strings.Split(token, ".")
-	if len(s) != 3 {
-		return nil, errors.New("token invalid")
-	}
-	b, err := base64.StdEncoding.WithPadding(base64.NoPadding).DecodeString(s[1])
+// ParseJWTToken verifies the signature and the expiry of token and
+// returns its claims.
+func (auth *JWTAuth) ParseJWTToken(token string) (*JwtInfo, error) {
+	info := new(JwtInfo)
+	_, err := jwt.ParseWithClaims(token, info, func(t *jwt.Token) (interface{}, error) {
+		auth.mu.RLock()
+		defer auth.mu.RUnlock()
+		key := auth.signing
+		if kid, ok := t.Header["kid"].(string); ok {
+			key = auth.keys[kid]
+		}
+		if key == nil {
+			return nil, errors.New("unknown signing key")
+		}
+		if t.Method.Alg() != key.Method.Alg() {
+			return nil, errors.New("unexpected signing method")
+		}
+		return key.Public, nil
+	})
 	if err != nil {
-		log.Println(err)
 		return nil, err
 	}
-	var info JwtInfo
-	err = json.Unmarshal(b, &info)
-	if err != nil {
-		log.Println("info")
-		log.Println(info)
-		return nil, err
+	if info.Subject == "" {
+		return nil, errors.New("token has no subject")
 	}
-	return &info, nil
+	return info, nil
 }
 
+// getInfo returns the claims verified by AuthHandler.
 func getInfo(ctx context.Context) (*JwtInfo, error) {
-	token, err := GetToken(ctx)
-	if err != nil {
-		return nil, err
-	}
-	info, err := getInfoFromToken(token)
-	if err != nil {
-		return nil, err
+	info, ok := ctx.Value(k).(*JwtInfo)
+	if !ok {
+		return nil, errors.New("request is not authenticated")
 	}
 	return info, nil
 }
@@ -174,23 +148,79 @@ type tk string
 
 const k tk = "token"
 
-// AuthHandler judges token is valid
-func AuthHandler(ctx context.Context) (context.Context, error) {
-	md, _ := metadata.FromIncomingContext(ctx)
-	v := md.Get("token")
-	if len(v) != 1 {
+// publicMethods can be called without a token.
+var publicMethods = map[string]bool{
+	"/api.PdnsService/Ping":          true,
+	"/api.PdnsService/CreateAccount": true,
+	"/api.PdnsService/GetToken":      true,
+	"/api.PdnsService/RefreshToken":  true,
+	"/api.PdnsService/GetJWKS":       true,
+
+	"/api.PdnsService/RequestPasswordReset": true,
+	"/api.PdnsService/ResetPassword":        true,
+	"/api.PdnsService/VerifyEmail":          true,
+	"/api.PdnsService/ExchangeOIDCToken":    true,
+}
+
+// AuthFuncOverride lets public methods through and requires a verified
+// token on every other method.
+func (s *server) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
+	if publicMethods[fullMethodName] {
 		return ctx, nil
 	}
-	token, err := jwt.Parse(v[0], func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-			return nil, errors.New("error on parsing jwt")
-		}
-		return authInstance.PublicKey, nil
-	})
+	return AuthHandler(ctx)
+}
+
+// AuthHandler authenticates a call and records the caller for the audit
+// log.
+func AuthHandler(ctx context.Context) (context.Context, error) {
+	newCtx, err := authenticate(ctx)
 	if err != nil {
 		return nil, err
 	}
+	setAuditCaller(newCtx)
+	return newCtx, nil
+}
 
-	newCtx := context.WithValue(ctx, k, token)
+// authenticate verifies the token, or the API key if one is given, and
+// stores its claims in the context. Without either, a registered client
+// certificate is accepted.
+func authenticate(ctx context.Context) (context.Context, error) {
+	if key, err := GetAPIKey(ctx); err == nil {
+		return APIKeyHandler(ctx, key)
+	}
+	token, err := GetToken(ctx)
+	if err != nil {
+		if cert := peerCertificate(ctx); cert != nil {
+			return CertificateHandler(ctx, cert)
+		}
+		return nil, unauthenticated("token is required")
+	}
+	info, err := authInstance.ParseJWTToken(token)
+	if err != nil {
+		return nil, unauthenticated(err.Error())
+	}
+	var (
+		gen       int64
+		suspended bool
+	)
+	err = GetDB().QueryRowContext(ctx, "SELECT token_generation, suspended_at IS NOT NULL FROM accounts WHERE email = $1;", info.Subject).Scan(&gen, &suspended)
+	if err == sql.ErrNoRows {
+		return nil, unauthenticated("account not found")
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if gen != info.Generation {
+		return nil, unauthenticated("token has been revoked")
+	}
+
+	newCtx := context.WithValue(ctx, k, info)
+	if info.Impersonator != "" {
+		newCtx = restrict(newCtx, readOnly())
+	}
+	if suspended {
+		newCtx = restrict(newCtx, accountSuspended())
+	}
 	return newCtx, nil
 }
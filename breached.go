@@ -0,0 +1,116 @@
+package main
+
+// breachedPasswords are common passwords from public breach corpora, one
+// per line. Only passwords of at least 10 characters, the default
+// PASSWORD_MIN_LENGTH, are listed, as the length check rejects shorter
+// ones already. More can be added with PASSWORD_BLOCKLIST.
+const breachedPasswords = `
+1234567890
+qwertyuiop
+password123
+password1234
+password12
+qwerty12345
+qwerty1234
+qwertyuiop123
+1qaz2wsx3edc
+1q2w3e4r5t
+1q2w3e4r5t6y
+q1w2e3r4t5
+q1w2e3r4t5y6
+asdfghjkl1
+zxcvbnm123
+0987654321
+1234567891
+12345678910
+123456789a
+a123456789
+abcdefghij
+iloveyou123
+welcome123
+welcome2020
+letmein123
+administrator
+changeme123
+testing123
+football123
+basketball
+summer2019
+summer2020
+summer2021
+summer2022
+summer2023
+summer2024
+winter2020
+winter2021
+winter2022
+winter2023
+spring2023
+autumn2023
+Spring2024!
+Summer2024!
+Password1!
+Password123!
+Password2020
+Password2021
+Password2022
+Password2023
+Password2024
+Welcome123!
+Qwerty123!
+Qwertyuiop1
+P@ssw0rd123
+Aa12345678
+Iloveyou1!
+Changeme1!
+Changeme123!
+Football1!
+Monkey123!
+Dragon123!
+Sunshine1!
+Princess1!
+Master123!
+Superman1!
+Batman123!
+correcthorsebatterystaple
+correcthorse
+trustno1trustno1
+passwordpassword
+1111111111
+0000000000
+1212121212
+1122334455
+9876543210
+5555555555
+7777777777
+0123456789
+1234512345
+1234554321
+1357924680
+123qweasdzxc
+qweasdzxc123
+zaq12wsxcde3
+1qazxsw23edc
+qazwsxedcrfv
+qwerty123456
+abcd123456
+abc1234567
+password12345
+passw0rd123
+iloveyou12
+iloveyou1234
+welcome1234
+baseball123
+basketball1
+sunshine123
+princess123
+superman123
+starwars123
+pokemon123
+liverpool123
+arsenal123
+chelsea123
+manchester
+computer123
+michael123
+`
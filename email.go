@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	"go.uber.org/zap"
+)
+
+// maxEmailLength is the longest address which can be used in SMTP.
+const maxEmailLength = 254
+
+const verificationBody = `Welcome to pdns-grpc.
+
+Verification token: %s
+
+Call verifyEmail with the token to verify your address. It expires at %s.
+`
+
+// validateEmail accepts a bare address such as user@example.com. Display
+// names and comments are rejected.
+func validateEmail(email string) error {
+	if email == "" {
+		return errors.New("email is required")
+	}
+	if len(email) > maxEmailLength {
+		return fmt.Errorf("email must be at most %d bytes", maxEmailLength)
+	}
+	a, err := mail.ParseAddress(email)
+	if err != nil || a.Address != email {
+		return errors.New("email is not a valid address")
+	}
+	return nil
+}
+
+// newVerification replaces the verification token of account. The token
+// has to be mailed after the transaction commits.
+func newVerification(ctx context.Context, tx *sql.Tx, account string) (string, time.Time, error) {
+	_, err := tx.ExecContext(ctx, "UPDATE email_verifications SET used = true WHERE account = $1 AND NOT used;", account)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	token, err := randomString(32)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expires := time.Now().Add(emailVerificationTTL)
+	_, err = tx.ExecContext(ctx, "INSERT INTO email_verifications(account,token_hash,expires_at) VALUES ($1,$2,$3);", account, hashToken(token), expires)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expires, nil
+}
+
+func sendVerification(email string, token string, expires time.Time) {
+	err := GetMailer().Send(email, "Verify your email", fmt.Sprintf(verificationBody, token, expires.UTC().Format(time.RFC1123)))
+	if err != nil {
+		logger.Error("failed to send verification mail", zap.Error(err))
+	}
+}
+
+// checkZoneLimit fails if account has not verified its email and already
+// created UNVERIFIED_ZONE_LIMIT zones. Without UNVERIFIED_ZONE_LIMIT there
+// is no limit.
+func checkZoneLimit(ctx context.Context, tx *sql.Tx, account string) error {
+	if unverifiedZoneLimit < 0 {
+		return nil
+	}
+	var verified bool
+	err := tx.QueryRowContext(ctx, "SELECT email_verified FROM accounts WHERE id = $1;", account).Scan(&verified)
+	if err != nil {
+		return err
+	}
+	if verified {
+		return nil
+	}
+	var n int
+	err = tx.QueryRowContext(ctx, "SELECT count(*) FROM domains WHERE account = $1;", account).Scan(&n)
+	if err != nil {
+		return err
+	}
+	if n >= unverifiedZoneLimit {
+		return failedPrecondition("email", fmt.Sprintf("verify your email to create more than %d zones", unverifiedZoneLimit))
+	}
+	return nil
+}
+
+// VerifyEmail marks the address of the account as verified with the
+// mailed token. It does not need a session, so the token alone suffices.
+func (s *server) VerifyEmail(ctx context.Context, in *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	if in.GetToken() == "" {
+		return nil, badRequest("token", errors.New("token is required"))
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	var id, account string
+	err = tx.QueryRowContext(ctx, "SELECT id, account FROM email_verifications WHERE token_hash = $1 AND NOT used AND expires_at > now();", hashToken(in.GetToken())).Scan(&id, &account)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return nil, unauthenticated("verification token is invalid")
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE email_verifications SET used = true WHERE id = $1;", id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var email string
+	err = tx.QueryRowContext(ctx, "UPDATE accounts SET email_verified = true WHERE id = $1 RETURNING email;", account).Scan(&email)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	setAuditEmail(ctx, email)
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.VerifyEmailResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
+// ResendVerification mails a new verification token. Older tokens stop
+// working.
+func (s *server) ResendVerification(ctx context.Context, in *empty.Empty) (*pb.ResendVerificationResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var (
+		email    string
+		verified bool
+	)
+	err = tx.QueryRowContext(ctx, "SELECT email, email_verified FROM accounts WHERE id = $1;", a).Scan(&email, &verified)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if verified {
+		tx.Rollback()
+		return nil, failedPrecondition("email", "the email is already verified")
+	}
+	token, expires, err := newVerification(ctx, tx, a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	sendVerification(email, token, expires)
+	return &pb.ResendVerificationResponse{Status: pb.ResponseStatus_Ok}, nil
+}
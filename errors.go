@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// withDetails attaches details to a status, falling back to the bare
+// status if they cannot be marshaled.
+func withDetails(st *status.Status, details ...proto.Message) error {
+	d, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return d.Err()
+}
+
+func notFound(resource string, name string) error {
+	return withDetails(status.New(codes.NotFound, resource+" not found"),
+		&errdetails.ResourceInfo{ResourceType: resource, ResourceName: name})
+}
+
+func alreadyExists(resource string, name string, msg string) error {
+	return withDetails(status.New(codes.AlreadyExists, msg),
+		&errdetails.ResourceInfo{ResourceType: resource, ResourceName: name})
+}
+
+func permissionDenied(resource string, name string) error {
+	return withDetails(status.New(codes.PermissionDenied, "permission denied on "+resource),
+		&errdetails.ResourceInfo{ResourceType: resource, ResourceName: name})
+}
+
+func unauthenticated(msg string) error {
+	return status.Error(codes.Unauthenticated, msg)
+}
+
+func failedPrecondition(subject string, desc string) error {
+	return withDetails(status.New(codes.FailedPrecondition, desc),
+		&errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{{Type: "STATE", Subject: subject, Description: desc}}})
+}
+
+// toStatus converts any error returned from a handler into a gRPC
+// status error. Errors which are already statuses are left untouched.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch err {
+	case sql.ErrNoRows:
+		return status.Error(codes.NotFound, "not found")
+	case context.Canceled:
+		return status.Error(codes.Canceled, err.Error())
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	if e, ok := err.(*pq.Error); ok {
+		switch e.Code.Name() {
+		case "serialization_failure", "deadlock_detected":
+			return withDetails(status.New(codes.Aborted, "transaction aborted, retry the request"),
+				&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(100 * time.Millisecond)})
+		case "unique_violation":
+			return status.Error(codes.AlreadyExists, "already exists")
+		case "string_data_right_truncation", "check_violation", "invalid_text_representation":
+			return status.Error(codes.InvalidArgument, e.Message)
+		}
+	}
+	logger.Error("internal error", zap.Error(err))
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// ErrorHandler converts handler errors into gRPC status errors.
+func ErrorHandler(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
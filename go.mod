@@ -1,6 +1,6 @@
 module github.com/KoyamaSohei/special-seminar-api
 
-go 1.12
+go 1.13
 
 require (
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
@@ -10,5 +10,6 @@ require (
 	github.com/miekg/dns v1.1.27
 	github.com/stretchr/testify v1.4.0
 	go.uber.org/zap v1.13.0
+	google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55
 	google.golang.org/grpc v1.26.0
 )
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes/empty"
+	"go.uber.org/zap"
+)
+
+// signingMethodEdDSA implements EdDSA (RFC 8037) with Ed25519 keys, which
+// jwt-go does not support on its own.
+type signingMethodEdDSA struct{}
+
+var edDSA = &signingMethodEdDSA{}
+
+func init() {
+	jwt.RegisterSigningMethod(edDSA.Alg(), func() jwt.SigningMethod { return edDSA })
+}
+
+func (m *signingMethodEdDSA) Alg() string {
+	return "EdDSA"
+}
+
+func (m *signingMethodEdDSA) Verify(signingString, signature string, key interface{}) error {
+	pub, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return jwt.ErrInvalidKeyType
+	}
+	sig, err := jwt.DecodeSegment(signature)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(pub, []byte(signingString), sig) {
+		return errors.New("ed25519: verification error")
+	}
+	return nil
+}
+
+func (m *signingMethodEdDSA) Sign(signingString string, key interface{}) (string, error) {
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return "", jwt.ErrInvalidKeyType
+	}
+	return jwt.EncodeSegment(ed25519.Sign(priv, []byte(signingString))), nil
+}
+
+// signingKey is a key used to sign or verify tokens. Private is nil for
+// keys which are only kept to verify tokens signed before a rotation.
+type signingKey struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Private crypto.Signer
+	Public  crypto.PublicKey
+}
+
+func newSigningKey(private crypto.Signer, public crypto.PublicKey) (*signingKey, error) {
+	var method jwt.SigningMethod
+	switch pub := public.(type) {
+	case *rsa.PublicKey:
+		method = jwt.SigningMethodRS512
+	case *ecdsa.PublicKey:
+		switch pub.Curve {
+		case elliptic.P256():
+			method = jwt.SigningMethodES256
+		case elliptic.P384():
+			method = jwt.SigningMethodES384
+		case elliptic.P521():
+			method = jwt.SigningMethodES512
+		default:
+			return nil, errors.New("unsupported elliptic curve")
+		}
+	case ed25519.PublicKey:
+		method = edDSA
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", public)
+	}
+	k := &signingKey{Method: method, Private: private, Public: public}
+	k.ID = thumbprint(k.JWK())
+	return k, nil
+}
+
+// JWK returns the public part of k as a JSON Web Key.
+func (k *signingKey) JWK() *pb.JWK {
+	enc := base64.RawURLEncoding.EncodeToString
+	jwk := &pb.JWK{Kid: k.ID, Use: "sig", Alg: k.Method.Alg()}
+	switch pub := k.Public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = enc(pub.N.Bytes())
+		jwk.E = enc(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		jwk.Kty = "EC"
+		jwk.Crv = pub.Curve.Params().Name
+		jwk.X = enc(pad(pub.X.Bytes(), size))
+		jwk.Y = enc(pad(pub.Y.Bytes(), size))
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = enc(pub)
+	}
+	return jwk
+}
+
+func pad(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b
+	}
+	return append(make([]byte, size-len(b)), b...)
+}
+
+// thumbprint computes the RFC 7638 thumbprint of a JWK, used as its kid.
+func thumbprint(jwk *pb.JWK) string {
+	var m map[string]string
+	switch jwk.Kty {
+	case "RSA":
+		m = map[string]string{"e": jwk.E, "kty": jwk.Kty, "n": jwk.N}
+	case "EC":
+		m = map[string]string{"crv": jwk.Crv, "kty": jwk.Kty, "x": jwk.X, "y": jwk.Y}
+	default:
+		m = map[string]string{"crv": jwk.Crv, "kty": jwk.Kty, "x": jwk.X}
+	}
+	// encoding/json sorts map keys, which gives the canonical form.
+	b, _ := json.Marshal(m)
+	h := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
+
+// readKeys reads every PEM block of a key file.
+func readKeys(path string) ([]*signingKey, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var li []*signingKey
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			break
+		}
+		var key interface{}
+		switch block.Type {
+		case "RSA PRIVATE KEY":
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		case "PRIVATE KEY":
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		case "RSA PUBLIC KEY":
+			key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		case "PUBLIC KEY":
+			key, err = x509.ParsePKIXPublicKey(block.Bytes)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		var k *signingKey
+		if s, ok := key.(crypto.Signer); ok {
+			k, err = newSigningKey(s, s.Public())
+		} else {
+			k, err = newSigningKey(nil, key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		li = append(li, k)
+	}
+	if len(li) == 0 {
+		return nil, fmt.Errorf("%s: no key found", path)
+	}
+	return li, nil
+}
+
+// Reload reads the key files again. The first private key becomes the
+// signing key, and every key is accepted for verification. On error the
+// keys loaded before are kept.
+func (auth *JWTAuth) Reload() error {
+	keys := make(map[string]*signingKey)
+	mod := make(map[string]time.Time)
+	var signing *signingKey
+	for _, p := range auth.paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+		mod[p] = fi.ModTime()
+		li, err := readKeys(p)
+		if err != nil {
+			return err
+		}
+		for _, k := range li {
+			if k.Private != nil && signing == nil {
+				signing = k
+			}
+			if old, ok := keys[k.ID]; !ok || old.Private == nil {
+				keys[k.ID] = k
+			}
+		}
+	}
+	if signing == nil {
+		return errors.New("no private key to sign tokens with")
+	}
+	auth.mu.Lock()
+	auth.keys = keys
+	auth.signing = signing
+	auth.modTimes = mod
+	auth.mu.Unlock()
+	logger.Info("loaded jwt keys", zap.Int("keys", len(keys)), zap.String("kid", signing.ID))
+	return nil
+}
+
+// changed reports whether a key file was modified since the last reload.
+func (auth *JWTAuth) changed() bool {
+	auth.mu.RLock()
+	defer auth.mu.RUnlock()
+	for _, p := range auth.paths {
+		fi, err := os.Stat(p)
+		if err != nil || !fi.ModTime().Equal(auth.modTimes[p]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Watch reloads the keys when a key file changes or on SIGHUP, so new
+// keys can be rolled out without a restart.
+func (auth *JWTAuth) Watch(interval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if !auth.changed() {
+				continue
+			}
+		case <-hup:
+		}
+		if err := auth.Reload(); err != nil {
+			logger.Error("failed to reload jwt keys", zap.Error(err))
+		}
+	}
+}
+
+// JWKS returns every verification key.
+func (auth *JWTAuth) JWKS() []*pb.JWK {
+	auth.mu.RLock()
+	defer auth.mu.RUnlock()
+	li := make([]*pb.JWK, 0, len(auth.keys))
+	for _, k := range auth.keys {
+		li = append(li, k.JWK())
+	}
+	return li
+}
+
+func (s *server) GetJWKS(ctx context.Context, in *empty.Empty) (*pb.GetJWKSResponse, error) {
+	return &pb.GetJWKSResponse{Status: pb.ResponseStatus_Ok, Keys: authInstance.JWKS()}, nil
+}
+
+func splitList(s string) []string {
+	li := make([]string, 0, 2)
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			li = append(li, v)
+		}
+	}
+	return li
+}
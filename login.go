@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	attemptEmail = "email"
+	attemptPeer  = "peer"
+
+	// peerAttemptFactor scales the free attempts of a peer address, as it
+	// may be shared by many users behind NAT.
+	peerAttemptFactor = 4
+
+	// loginWindow is how long failures are remembered after the last one.
+	loginWindow = 24 * time.Hour
+)
+
+// loginDelay returns how long logins are refused after failures. The delay
+// doubles with every failure past free, up to LOGIN_LOCKOUT.
+func loginDelay(failures int, free int) time.Duration {
+	if failures < free {
+		return 0
+	}
+	n := uint(failures - free)
+	if n >= 32 {
+		return loginLockout
+	}
+	d := loginBackoff << n
+	if d <= 0 || d > loginLockout {
+		return loginLockout
+	}
+	return d
+}
+
+// peerAddr returns the IP address of the client.
+func peerAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// loginLocked returns how long the email or the address is locked out.
+// Failures are recorded outside the login transaction, so it queries the
+// database directly.
+func loginLocked(ctx context.Context, email string, addr string) (time.Duration, error) {
+	return attemptsLocked(ctx, attemptEmail, email, attemptPeer, addr)
+}
+
+// attemptsLocked returns how long email or addr is locked out, counting
+// the attempts of emailKind and peerKind.
+func attemptsLocked(ctx context.Context, emailKind string, email string, peerKind string, addr string) (time.Duration, error) {
+	var until pq.NullTime
+	err := GetDB().QueryRowContext(ctx, "SELECT max(locked_until) FROM login_attempts WHERE ((kind = $1 AND subject = $2) OR (kind = $3 AND subject = $4)) AND locked_until > now();",
+		emailKind, email, peerKind, addr).Scan(&until)
+	if err != nil || !until.Valid {
+		return 0, err
+	}
+	return time.Until(until.Time), nil
+}
+
+// checkLoginLocked fails with lockedOut while email or addr is locked out.
+// Calls which check a password or a one-time password of a signed in
+// account use it, so they cannot be used to guess around the lockout.
+func checkLoginLocked(ctx context.Context, email string, addr string) error {
+	d, err := loginLocked(ctx, email, addr)
+	if err != nil {
+		return err
+	}
+	if d > 0 {
+		return lockedOut(d)
+	}
+	return nil
+}
+
+// recordFailure counts a failed login of subject and locks it out once the
+// free attempts are used up.
+func recordFailure(ctx context.Context, kind string, subject string, free int) error {
+	var failures int
+	err := GetDB().QueryRowContext(ctx, `INSERT INTO login_attempts(kind,subject,failures,updated_at) VALUES ($1,$2,1,now())
+ON CONFLICT (kind,subject) DO UPDATE SET failures = CASE WHEN login_attempts.updated_at < $3 THEN 1 ELSE login_attempts.failures + 1 END, updated_at = now()
+RETURNING failures;`, kind, subject, time.Now().Add(-loginWindow)).Scan(&failures)
+	if err != nil {
+		return err
+	}
+	d := loginDelay(failures, free)
+	if d == 0 {
+		return nil
+	}
+	_, err = GetDB().ExecContext(ctx, "UPDATE login_attempts SET locked_until = $1 WHERE kind = $2 AND subject = $3;", time.Now().Add(d), kind, subject)
+	return err
+}
+
+// loginFailed records a failed login of email from addr. Errors are only
+// logged, as the caller already has an error to return.
+func loginFailed(ctx context.Context, email string, addr string) {
+	if err := recordFailure(ctx, attemptEmail, email, loginFreeAttempts); err != nil {
+		logger.Error("failed to record login failure", zap.Error(err))
+	}
+	if addr == "" {
+		return
+	}
+	if err := recordFailure(ctx, attemptPeer, addr, loginFreeAttempts*peerAttemptFactor); err != nil {
+		logger.Error("failed to record login failure", zap.Error(err))
+	}
+}
+
+// loginSucceeded clears the failures of email. Failures of the address
+// are kept, so one valid account does not unlock guessing at others.
+func loginSucceeded(ctx context.Context, tx *sql.Tx, email string) error {
+	_, err := tx.ExecContext(ctx, "DELETE FROM login_attempts WHERE kind = $1 AND subject = $2;", attemptEmail, email)
+	return err
+}
+
+// lockedOut is returned while logins are refused. It is the same for
+// unknown emails, so it cannot be used to find accounts.
+func lockedOut(d time.Duration) error {
+	return withDetails(status.New(codes.ResourceExhausted, "too many failed logins, try again later"),
+		&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(d)})
+}
+
+// invalidLogin is returned for unknown emails and wrong passwords alike.
+func invalidLogin() error {
+	return unauthenticated("email or password is incorrect")
+}
+
+// requireAdmin fails unless account is a server administrator.
+func requireAdmin(ctx context.Context, tx *sql.Tx, account string) error {
+	var admin bool
+	err := tx.QueryRowContext(ctx, "SELECT is_admin FROM accounts WHERE id = $1;", account).Scan(&admin)
+	if err != nil {
+		return err
+	}
+	if !admin {
+		return permissionDenied("server", "admin")
+	}
+	return nil
+}
+
+// UnlockAccount clears the failed logins of an email, and of a peer
+// address if one is given. Only administrators can call it.
+func (s *server) UnlockAccount(ctx context.Context, in *pb.UnlockAccountRequest) (*pb.UnlockAccountResponse, error) {
+	if in.GetEmail() == "" && in.GetAddress() == "" {
+		return nil, badRequest("email", errors.New("email or address is required"))
+	}
+	if in.GetAddress() != "" && net.ParseIP(in.GetAddress()) == nil {
+		return nil, badRequest("address", errors.New("address is not an IP address"))
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = requireAdmin(ctx, tx, a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM login_attempts WHERE (kind = $1 AND subject = $2) OR (kind = $3 AND subject = $4);",
+		attemptEmail, in.GetEmail(), attemptPeer, in.GetAddress())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("account unlocked", zap.String("email", in.GetEmail()), zap.String("address", in.GetAddress()), zap.String("by", a))
+	return &pb.UnlockAccountResponse{Status: pb.ResponseStatus_Ok}, nil
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginDelay(t *testing.T) {
+	assert.Equal(t, time.Duration(0), loginDelay(4, 5))
+	assert.Equal(t, loginBackoff, loginDelay(5, 5))
+	assert.Equal(t, 4*loginBackoff, loginDelay(7, 5))
+	assert.Equal(t, loginLockout, loginDelay(30, 5))
+	assert.Equal(t, loginLockout, loginDelay(500, 5))
+}
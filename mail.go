@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/smtp"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Mailer sends mail to users.
+type Mailer interface {
+	Send(to string, subject string, body string) error
+}
+
+// message formats a plain text mail.
+func message(from string, to string, subject string, body string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", to)
+	fmt.Fprintf(&b, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(strings.Replace(body, "\n", "\r\n", -1))
+	return b.Bytes()
+}
+
+// smtpMailer sends mail through an SMTP server, with PLAIN auth if a user
+// is set.
+type smtpMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func (m *smtpMailer) Send(to string, subject string, body string) error {
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{to}, message(m.from, to, subject, body))
+}
+
+// fileMailer writes each mail to a file in dir instead of sending it. The
+// files are readable by everyone so that tests can pick up the mail.
+type fileMailer struct {
+	dir  string
+	from string
+}
+
+func (m *fileMailer) Send(to string, subject string, body string) error {
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' {
+			return '_'
+		}
+		return r
+	}, to))
+	return ioutil.WriteFile(filepath.Join(m.dir, name), message(m.from, to, subject, body), 0644)
+}
+
+// logMailer writes mail to the log. It is meant for development only, as
+// mail may contain secrets.
+type logMailer struct{}
+
+func (m *logMailer) Send(to string, subject string, body string) error {
+	logger.Info("mail", zap.String("to", to), zap.String("subject", subject), zap.String("body", body))
+	return nil
+}
+
+var mailer Mailer
+
+// GetMailer returns the mailer selected by MAILER.
+func GetMailer() Mailer {
+	if mailer == nil {
+		switch mailerKind {
+		case "smtp":
+			m := &smtpMailer{addr: smtpAddr, from: mailFrom}
+			if smtpUser != "" {
+				host, _, _ := net.SplitHostPort(smtpAddr)
+				m.auth = smtp.PlainAuth("", smtpUser, smtpPass, host)
+			}
+			mailer = m
+		case "file":
+			mailer = &fileMailer{dir: mailDir, from: mailFrom}
+		default:
+			mailer = &logMailer{}
+		}
+	}
+	return mailer
+}
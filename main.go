@@ -2,10 +2,13 @@ package main
 
 import (
 	"database/sql"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"strconv"
+	"time"
 
 	pb "github.com/KoyamaSohei/special-seminar-api/proto"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -22,6 +25,46 @@ var (
 	psqlname = "postgres"
 	psqluser = "postgres"
 	psqlpass = ""
+
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 30 * 24 * time.Hour
+
+	jwtKeys       = []string{"jwtkey.rsa"}
+	jwtKeysReload = time.Minute
+
+	requireVerification = false
+	verificationTTL     = 72 * time.Hour
+	resolverAddr        = ""
+	nameserver          = ""
+
+	totpKey []byte
+
+	resetTokenTTL = time.Hour
+	mailerKind    = "log"
+	mailFrom      = "noreply@localhost"
+	mailDir       = "mail"
+	smtpAddr      = "localhost:25"
+	smtpUser      = ""
+	smtpPass      = ""
+
+	emailVerificationTTL = 72 * time.Hour
+	unverifiedZoneLimit  = -1
+
+	loginFreeAttempts = 5
+	loginBackoff      = time.Second
+	loginLockout      = 15 * time.Minute
+
+	passwordMinLength  = 10
+	passwordMinClasses = 3
+	passwordBlocklist  = ""
+
+	oidcConfig = ""
+
+	tlsCert       = ""
+	tlsKey        = ""
+	tlsClientCA   = ""
+	tlsClientAuth = "optional"
+	tlsReload     = time.Minute
 )
 
 var (
@@ -48,6 +91,166 @@ func initConfig() {
 	if pass := os.Getenv("GPGSQL_PASSWORD"); pass != "" {
 		psqlpass = pass
 	}
+	if ttl := os.Getenv("ACCESS_TOKEN_TTL"); ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			logger.Fatal("invalid ACCESS_TOKEN_TTL", zap.Error(err))
+		}
+		accessTokenTTL = d
+	}
+	if ttl := os.Getenv("REFRESH_TOKEN_TTL"); ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			logger.Fatal("invalid REFRESH_TOKEN_TTL", zap.Error(err))
+		}
+		refreshTokenTTL = d
+	}
+	if keys := os.Getenv("JWT_KEYS"); keys != "" {
+		jwtKeys = splitList(keys)
+	}
+	if interval := os.Getenv("JWT_KEYS_RELOAD"); interval != "" {
+		d, err := time.ParseDuration(interval)
+		if err != nil || d <= 0 {
+			logger.Fatal("invalid JWT_KEYS_RELOAD", zap.String("value", interval))
+		}
+		jwtKeysReload = d
+	}
+	if v := os.Getenv("REQUIRE_DOMAIN_VERIFICATION"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			logger.Fatal("invalid REQUIRE_DOMAIN_VERIFICATION", zap.Error(err))
+		}
+		requireVerification = b
+	}
+	if ttl := os.Getenv("DOMAIN_VERIFICATION_TTL"); ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			logger.Fatal("invalid DOMAIN_VERIFICATION_TTL", zap.Error(err))
+		}
+		verificationTTL = d
+	}
+	if addr := os.Getenv("RESOLVER"); addr != "" {
+		resolverAddr = addr
+	}
+	nameserver = mname
+	if ns := os.Getenv("NAMESERVER"); ns != "" {
+		nameserver = ns
+	}
+	if key := os.Getenv("TOTP_ENCRYPTION_KEY"); key != "" {
+		b, err := base64.StdEncoding.DecodeString(key)
+		if err != nil || len(b) != 32 {
+			logger.Fatal("TOTP_ENCRYPTION_KEY must be 32 bytes encoded in base64")
+		}
+		totpKey = b
+	}
+	if ttl := os.Getenv("RESET_TOKEN_TTL"); ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			logger.Fatal("invalid RESET_TOKEN_TTL", zap.Error(err))
+		}
+		resetTokenTTL = d
+	}
+	if kind := os.Getenv("MAILER"); kind != "" {
+		mailerKind = kind
+	}
+	if from := os.Getenv("MAIL_FROM"); from != "" {
+		mailFrom = from
+	}
+	if dir := os.Getenv("MAIL_DIR"); dir != "" {
+		mailDir = dir
+	}
+	if addr := os.Getenv("SMTP_ADDR"); addr != "" {
+		smtpAddr = addr
+	}
+	if user := os.Getenv("SMTP_USER"); user != "" {
+		smtpUser = user
+	}
+	if pass := os.Getenv("SMTP_PASSWORD"); pass != "" {
+		smtpPass = pass
+	}
+	if ttl := os.Getenv("EMAIL_VERIFICATION_TTL"); ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			logger.Fatal("invalid EMAIL_VERIFICATION_TTL", zap.Error(err))
+		}
+		emailVerificationTTL = d
+	}
+	if v := os.Getenv("UNVERIFIED_ZONE_LIMIT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			logger.Fatal("invalid UNVERIFIED_ZONE_LIMIT", zap.Error(err))
+		}
+		unverifiedZoneLimit = n
+	}
+	if v := os.Getenv("LOGIN_FREE_ATTEMPTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			logger.Fatal("invalid LOGIN_FREE_ATTEMPTS", zap.Error(err))
+		}
+		loginFreeAttempts = n
+	}
+	if v := os.Getenv("LOGIN_BACKOFF"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			logger.Fatal("invalid LOGIN_BACKOFF", zap.Error(err))
+		}
+		loginBackoff = d
+	}
+	if v := os.Getenv("LOGIN_LOCKOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			logger.Fatal("invalid LOGIN_LOCKOUT", zap.Error(err))
+		}
+		loginLockout = d
+	}
+	if v := os.Getenv("PASSWORD_MIN_LENGTH"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			logger.Fatal("invalid PASSWORD_MIN_LENGTH", zap.Error(err))
+		}
+		passwordMinLength = n
+	}
+	if v := os.Getenv("PASSWORD_MIN_CLASSES"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > 4 {
+			logger.Fatal("PASSWORD_MIN_CLASSES must be between 1 and 4")
+		}
+		passwordMinClasses = n
+	}
+	if path := os.Getenv("PASSWORD_BLOCKLIST"); path != "" {
+		passwordBlocklist = path
+	}
+	if path := os.Getenv("OIDC_CONFIG"); path != "" {
+		oidcConfig = path
+	}
+	if cert := os.Getenv("TLS_CERT"); cert != "" {
+		tlsCert = cert
+	}
+	if key := os.Getenv("TLS_KEY"); key != "" {
+		tlsKey = key
+	}
+	if (tlsCert == "") != (tlsKey == "") {
+		logger.Fatal("TLS_CERT and TLS_KEY must be set together")
+	}
+	if ca := os.Getenv("TLS_CLIENT_CA"); ca != "" {
+		tlsClientCA = ca
+	}
+	if mode := os.Getenv("TLS_CLIENT_AUTH"); mode != "" {
+		if mode != "optional" && mode != "require" {
+			logger.Fatal("TLS_CLIENT_AUTH must be optional or require")
+		}
+		tlsClientAuth = mode
+	}
+	if tlsClientCA != "" && tlsCert == "" {
+		logger.Fatal("TLS_CLIENT_CA requires TLS_CERT and TLS_KEY")
+	}
+	if v := os.Getenv("TLS_RELOAD"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			logger.Fatal("invalid TLS_RELOAD", zap.Error(err))
+		}
+		tlsReload = d
+	}
 	logger.Info("psqlhost: " + psqlhost)
 }
 
@@ -69,20 +272,52 @@ func main() {
 	ops.OutputPaths = []string{"stdout"}
 	logger, _ = ops.Build()
 	initConfig()
-	InitJWTAuth()
+	auth, err := InitJWTAuth()
+	if err != nil {
+		logger.Fatal("failed to load jwt keys", zap.Error(err))
+	}
+	go auth.Watch(jwtKeysReload)
+	breached, err = loadBreached()
+	if err != nil {
+		logger.Fatal("failed to load PASSWORD_BLOCKLIST", zap.Error(err))
+	}
+	if oidcConfig != "" {
+		oidcIssuers, err = loadOIDCIssuers(oidcConfig)
+		if err != nil {
+			logger.Fatal("failed to load OIDC_CONFIG", zap.Error(err))
+		}
+	}
 	lis, err := net.Listen("tcp", pdnshost+":"+pdnsport)
 	if err != nil {
 		logger.Error("failed to listen", zap.Error(err))
 	}
 	logger.Info("listening on " + pdnshost + " : " + pdnsport)
-	s := grpc.NewServer(
+	var opts []grpc.ServerOption
+	if tlsCert != "" {
+		r, err := NewTLSReloader()
+		if err != nil {
+			logger.Fatal("failed to load tls certificate", zap.Error(err))
+		}
+		go r.Watch(tlsReload)
+		opts = append(opts, grpc.Creds(r.Credentials()))
+	} else {
+		logger.Warn("TLS_CERT is not set, serving without TLS")
+	}
+	if mailerKind != "smtp" {
+		logger.Warn("MAILER is not smtp, mail with reset and verification tokens is kept on this host", zap.String("mailer", mailerKind))
+	}
+	s := grpc.NewServer(append(opts,
 		grpc_middleware.WithStreamServerChain(
 			grpc_auth.StreamServerInterceptor(AuthHandler),
 			grpc_zap.StreamServerInterceptor(zap.NewNop())),
 		grpc_middleware.WithUnaryServerChain(
+			AuditHandler,
 			grpc_auth.UnaryServerInterceptor(AuthHandler),
-			grpc_zap.UnaryServerInterceptor(logger)))
+			grpc_zap.UnaryServerInterceptor(logger),
+			ErrorHandler,
+			ScopeHandler))...)
 	pb.RegisterPdnsServiceServer(s, &server{})
+	pb.RegisterAdminServiceServer(s, &adminServer{})
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
@@ -0,0 +1,464 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	jwt "github.com/dgrijalva/jwt-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// oidcKeysTTL is how long fetched keys are used before they are
+	// fetched again.
+	oidcKeysTTL = time.Hour
+	// oidcRefetchInterval limits fetches caused by unknown key ids.
+	oidcRefetchInterval = time.Minute
+	// maxJWKSSize limits the size of a fetched key set.
+	maxJWKSSize = 1 << 20
+)
+
+// oidcIssuer is an OpenID Connect provider whose ID tokens are accepted
+// by ExchangeOIDCToken.
+type oidcIssuer struct {
+	Issuer    string   `json:"issuer"`
+	Audiences []string `json:"audiences"`
+	// JWKSURL or JWKSFile is where the signing keys of the issuer are.
+	JWKSURL  string `json:"jwks_url"`
+	JWKSFile string `json:"jwks_file"`
+	// AutoProvision creates an account on the first login.
+	AutoProvision bool `json:"auto_provision"`
+	// LinkAccounts lets the issuer log into an existing account with the
+	// same email.
+	LinkAccounts bool `json:"link_accounts"`
+	// Domains limits the email domains of the users, if it is not empty.
+	Domains []string `json:"domains"`
+
+	mu      sync.Mutex
+	keys    map[string]*oidcKey
+	fetched time.Time
+}
+
+type oidcKey struct {
+	alg string
+	key crypto.PublicKey
+}
+
+var oidcIssuers map[string]*oidcIssuer
+
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// loadOIDCIssuers reads the issuers from the JSON file at path.
+func loadOIDCIssuers(path string) (map[string]*oidcIssuer, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var li []*oidcIssuer
+	if err := json.Unmarshal(b, &li); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	m := make(map[string]*oidcIssuer)
+	for _, iss := range li {
+		if iss.Issuer == "" || len(iss.Audiences) == 0 {
+			return nil, fmt.Errorf("%s: issuer and audiences are required", path)
+		}
+		if (iss.JWKSURL == "") == (iss.JWKSFile == "") {
+			return nil, fmt.Errorf("%s: %s: either jwks_url or jwks_file is required", path, iss.Issuer)
+		}
+		if m[iss.Issuer] != nil {
+			return nil, fmt.Errorf("%s: %s is listed twice", path, iss.Issuer)
+		}
+		m[iss.Issuer] = iss
+	}
+	return m, nil
+}
+
+// jwkPublicKey converts a JWK into a public key.
+func jwkPublicKey(jwk *pb.JWK) (crypto.PublicKey, error) {
+	dec := base64.RawURLEncoding.DecodeString
+	switch jwk.Kty {
+	case "RSA":
+		n, err := dec(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := dec(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		if len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := dec(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := dec(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		pub := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return pub, nil
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := dec(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", jwk.Kty)
+}
+
+// keyAlgs are the algorithms accepted for each key type.
+var keyAlgs = map[string][]string{
+	"RSA": {"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"},
+	"EC":  {"ES256", "ES384", "ES512"},
+	"OKP": {"EdDSA"},
+}
+
+func (iss *oidcIssuer) fetch(ctx context.Context) (map[string]*oidcKey, error) {
+	var b []byte
+	if iss.JWKSFile != "" {
+		var err error
+		b, err = ioutil.ReadFile(iss.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		req, err := http.NewRequest("GET", iss.JWKSURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		res, err := httpClient.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("%s: %s", iss.JWKSURL, res.Status)
+		}
+		b, err = ioutil.ReadAll(io.LimitReader(res.Body, maxJWKSSize))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var set struct {
+		Keys []*pb.JWK `json:"keys"`
+	}
+	if err := json.Unmarshal(b, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*oidcKey)
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		pub, err := jwkPublicKey(jwk)
+		if err != nil {
+			logger.Warn("skipping OIDC key", zap.String("issuer", iss.Issuer), zap.String("kid", jwk.Kid), zap.Error(err))
+			continue
+		}
+		keys[jwk.Kid] = &oidcKey{alg: jwk.Alg, key: pub}
+	}
+	return keys, nil
+}
+
+// key returns the key with kid. The key set is fetched again when it is
+// old or the key is unknown, at most once per oidcRefetchInterval. The
+// lock is not held while fetching, so a slow issuer does not block the
+// calls which can use the current keys.
+func (iss *oidcIssuer) key(ctx context.Context, kid string) (*oidcKey, error) {
+	iss.mu.Lock()
+	keys := iss.keys
+	age := time.Since(iss.fetched)
+	stale := keys == nil || age > oidcKeysTTL || (keys[kid] == nil && age > oidcRefetchInterval)
+	if stale {
+		iss.fetched = time.Now()
+	}
+	iss.mu.Unlock()
+	if stale {
+		fetched, err := iss.fetch(ctx)
+		if err != nil && keys == nil {
+			return nil, err
+		}
+		if err != nil {
+			logger.Error("failed to fetch OIDC keys", zap.String("issuer", iss.Issuer), zap.Error(err))
+		} else {
+			iss.mu.Lock()
+			iss.keys = fetched
+			iss.mu.Unlock()
+			keys = fetched
+		}
+	}
+	if k := keys[kid]; k != nil {
+		return k, nil
+	}
+	if kid == "" && len(keys) == 1 {
+		for _, k := range keys {
+			return k, nil
+		}
+	}
+	return nil, errors.New("unknown signing key")
+}
+
+// keyType returns the JWK key type of pub.
+func keyType(pub crypto.PublicKey) string {
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		return "RSA"
+	case *ecdsa.PublicKey:
+		return "EC"
+	case ed25519.PublicKey:
+		return "OKP"
+	}
+	return ""
+}
+
+func hasAudience(claims jwt.MapClaims, audiences []string) bool {
+	var li []string
+	switch aud := claims["aud"].(type) {
+	case string:
+		li = []string{aud}
+	case []interface{}:
+		for _, v := range aud {
+			if s, ok := v.(string); ok {
+				li = append(li, s)
+			}
+		}
+	}
+	for _, a := range li {
+		for _, b := range audiences {
+			if a == b {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// oidcReauthWindow is how old an ID token may be to confirm a
+// destructive change.
+const oidcReauthWindow = 5 * time.Minute
+
+// oidcIdentity is a user authenticated by an issuer.
+type oidcIdentity struct {
+	issuer   *oidcIssuer
+	subject  string
+	email    string
+	issuedAt time.Time
+}
+
+// verifyIDToken checks the signature and the claims of an ID token issued
+// by one of issuers.
+func verifyIDToken(ctx context.Context, issuers map[string]*oidcIssuer, raw string) (*oidcIdentity, error) {
+	var iss *oidcIssuer
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		s, _ := claims["iss"].(string)
+		iss = issuers[s]
+		if iss == nil {
+			return nil, errors.New("unknown issuer")
+		}
+		kid, _ := t.Header["kid"].(string)
+		k, err := iss.key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		alg := t.Method.Alg()
+		if k.alg != "" && k.alg != alg {
+			return nil, errors.New("unexpected signing method")
+		}
+		for _, a := range keyAlgs[keyType(k.key)] {
+			if a == alg {
+				return k.key, nil
+			}
+		}
+		return nil, errors.New("unexpected signing method")
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.New("token has no expiry")
+	}
+	if !hasAudience(claims, iss.Audiences) {
+		return nil, errors.New("token is not for this server")
+	}
+	id := &oidcIdentity{issuer: iss}
+	if iat, ok := claims["iat"].(float64); ok {
+		id.issuedAt = time.Unix(int64(iat), 0)
+	}
+	id.subject, _ = claims["sub"].(string)
+	if id.subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+	id.email, _ = claims["email"].(string)
+	if err := validateEmail(id.email); err != nil {
+		return nil, err
+	}
+	// Some providers send email_verified as a string.
+	if v := claims["email_verified"]; v != true && v != "true" {
+		return nil, errors.New("email is not verified by the issuer")
+	}
+	if len(iss.Domains) > 0 {
+		domain := strings.ToLower(id.email[strings.LastIndex(id.email, "@")+1:])
+		ok := false
+		for _, d := range iss.Domains {
+			ok = ok || strings.ToLower(d) == domain
+		}
+		if !ok {
+			return nil, errors.New("email domain is not allowed for this issuer")
+		}
+	}
+	return id, nil
+}
+
+// oidcAccount returns the account of an identity, linking or creating one
+// if the issuer allows it.
+func oidcAccount(ctx context.Context, tx *sql.Tx, id *oidcIdentity) (string, string, error) {
+	var account, email string
+	err := tx.QueryRowContext(ctx, "SELECT a.id, a.email FROM oidc_identities i JOIN accounts a ON a.id = i.account WHERE i.issuer = $1 AND i.subject = $2;", id.issuer.Issuer, id.subject).Scan(&account, &email)
+	if err == nil {
+		return account, email, nil
+	}
+	if err != sql.ErrNoRows {
+		return "", "", err
+	}
+	err = tx.QueryRowContext(ctx, "SELECT id FROM accounts WHERE email = $1;", id.email).Scan(&account)
+	switch {
+	case err == nil && !id.issuer.LinkAccounts:
+		return "", "", alreadyExists("account", id.email, "this email is already registered")
+	case err == sql.ErrNoRows && !id.issuer.AutoProvision:
+		return "", "", permissionDenied("account", id.email)
+	case err == sql.ErrNoRows:
+		err = tx.QueryRowContext(ctx, "INSERT INTO accounts(email,password,email_verified) VALUES ($1,NULL,true) RETURNING id;", id.email).Scan(&account)
+	}
+	if err != nil {
+		return "", "", err
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO oidc_identities(issuer,subject,account) VALUES ($1,$2,$3);", id.issuer.Issuer, id.subject, account)
+	if err != nil {
+		return "", "", err
+	}
+	logger.Info("OIDC identity linked", zap.String("issuer", id.issuer.Issuer), zap.String("subject", id.subject), zap.String("account", account))
+	return account, id.email, nil
+}
+
+// checkOIDCLogin verifies that raw is a recent ID token of an identity
+// linked to account.
+func checkOIDCLogin(ctx context.Context, tx *sql.Tx, account string, raw string) error {
+	if len(oidcIssuers) == 0 {
+		return failedPrecondition("oidc", "OpenID Connect is not configured on this server")
+	}
+	id, err := verifyIDToken(ctx, oidcIssuers, raw)
+	if err != nil {
+		return unauthenticated("id token is invalid: " + err.Error())
+	}
+	if time.Since(id.issuedAt) > oidcReauthWindow {
+		return unauthenticated("id token is too old, sign in again")
+	}
+	var linked string
+	err = tx.QueryRowContext(ctx, "SELECT account FROM oidc_identities WHERE issuer = $1 AND subject = $2;", id.issuer.Issuer, id.subject).Scan(&linked)
+	if err == sql.ErrNoRows || (err == nil && linked != account) {
+		return unauthenticated("id token is not of this account")
+	}
+	return err
+}
+
+// ExchangeOIDCToken issues tokens of this server for an ID token of a
+// configured OpenID Connect issuer. The login is refused while the
+// account is locked out, and needs the otp if two-factor authentication
+// is enabled, as with GetToken. Tokens of suspended accounts are
+// restricted when they are used.
+func (s *server) ExchangeOIDCToken(ctx context.Context, in *pb.ExchangeOIDCTokenRequest) (*pb.ExchangeOIDCTokenResponse, error) {
+	if len(oidcIssuers) == 0 {
+		return nil, failedPrecondition("oidc", "OpenID Connect is not configured on this server")
+	}
+	if in.GetIdToken() == "" {
+		return nil, badRequest("idToken", errors.New("idToken is required"))
+	}
+	id, err := verifyIDToken(ctx, oidcIssuers, in.GetIdToken())
+	if err != nil {
+		return nil, unauthenticated("id token is invalid: " + err.Error())
+	}
+	setAuditEmail(ctx, id.email)
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	account, email, err := oidcAccount(ctx, tx, id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	addr := peerAddr(ctx)
+	if err := checkLoginLocked(ctx, email, addr); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = verifyLogin(ctx, tx, account, in.GetOtp())
+	if err != nil {
+		tx.Rollback()
+		if in.GetOtp() != "" && status.Code(err) == codes.Unauthenticated {
+			loginFailed(ctx, email, addr)
+		}
+		return nil, err
+	}
+	err = loginSucceeded(ctx, tx, email)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	token, refresh, err := issueTokens(ctx, tx, account, email)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExchangeOIDCTokenResponse{Status: pb.ResponseStatus_Ok, Token: token, RefreshToken: refresh}, nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyIDToken(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := newSigningKey(priv, priv.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "oidc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	b, _ := json.Marshal(map[string][]*pb.JWK{"keys": {key.JWK()}})
+	jwks := filepath.Join(dir, "jwks.json")
+	if err := ioutil.WriteFile(jwks, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	issuers := map[string]*oidcIssuer{
+		"https://sso.example.com": {Issuer: "https://sso.example.com", Audiences: []string{"pdns"}, JWKSFile: jwks, Domains: []string{"example.com"}},
+	}
+	sign := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(key.Method, claims)
+		token.Header["kid"] = key.ID
+		s, err := token.SignedString(priv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	claims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":            "https://sso.example.com",
+			"aud":            []string{"other", "pdns"},
+			"sub":            "1234",
+			"email":          "user@example.com",
+			"email_verified": true,
+			"exp":            time.Now().Add(time.Minute).Unix(),
+		}
+	}
+	ctx := context.Background()
+
+	c := claims()
+	c["iat"] = time.Now().Unix()
+	id, err := verifyIDToken(ctx, issuers, sign(c))
+	if assert.Equal(t, nil, err) {
+		assert.Equal(t, "1234", id.subject)
+		assert.Equal(t, "user@example.com", id.email)
+		assert.True(t, time.Since(id.issuedAt) < oidcReauthWindow)
+	}
+	for name, edit := range map[string]func(jwt.MapClaims){
+		"issuer":     func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" },
+		"audience":   func(c jwt.MapClaims) { c["aud"] = "other" },
+		"expired":    func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+		"no expiry":  func(c jwt.MapClaims) { delete(c, "exp") },
+		"unverified": func(c jwt.MapClaims) { c["email_verified"] = false },
+		"domain":     func(c jwt.MapClaims) { c["email"] = "user@example.net" },
+		"subject":    func(c jwt.MapClaims) { delete(c, "sub") },
+	} {
+		c := claims()
+		edit(c)
+		_, err := verifyIDToken(ctx, issuers, sign(c))
+		assert.NotEqual(t, nil, err, name)
+	}
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, claims())
+	forged.Header["kid"] = key.ID
+	s, _ := forged.SignedString([]byte("secret"))
+	_, err = verifyIDToken(ctx, issuers, s)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestOIDCKeyFetchUnlocked(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.Write([]byte(`{"keys":[]}`))
+	}))
+	defer srv.Close()
+	known := &oidcKey{alg: "ES256"}
+	iss := &oidcIssuer{Issuer: "https://sso.example.com", JWKSURL: srv.URL,
+		keys: map[string]*oidcKey{"a": known}, fetched: time.Now().Add(-2 * oidcRefetchInterval)}
+	ctx := context.Background()
+	done := make(chan struct{})
+	go func() {
+		iss.key(ctx, "b")
+		close(done)
+	}()
+	<-started
+	k, err := iss.key(ctx, "a")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, known, k)
+	close(release)
+	<-done
+}
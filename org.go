@@ -0,0 +1,360 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/lib/pq"
+)
+
+// noRole is lower than every role, it is held by accounts without access.
+const noRole pb.Role = -1
+
+func parseRole(s string) pb.Role {
+	r, ok := pb.Role_value[s]
+	if !ok {
+		return noRole
+	}
+	return pb.Role(r)
+}
+
+// zoneAccess is what an account may do on a domain. Subtree and Types
+// are only set when the access comes from a limited zone share.
+type zoneAccess struct {
+	ID      string
+	Role    pb.Role
+	Subtree string
+	Types   []string
+}
+
+// allows reports whether the records named name of type t are within the
+// limits of the access.
+func (z *zoneAccess) allows(name string, t pb.RRType) bool {
+	if z.Subtree != "" && !inZone(name, z.Subtree) {
+		return false
+	}
+	if len(z.Types) == 0 {
+		return true
+	}
+	for _, v := range z.Types {
+		if v == t.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizeZone returns the access of account to the domain if it holds
+// at least role need on it. Accounts are owners of their personal
+// domains, members hold their member role on the domains of an
+// organization, and zone shares grant a role limited to a subtree or
+// types. It fails with NotFound if nobody owns the domain and
+// PermissionDenied otherwise. Personal domains of suspended accounts
+// cannot be changed by anyone.
+func authorizeZone(ctx context.Context, tx *sql.Tx, name string, account string, need pb.Role) (*zoneAccess, error) {
+	var (
+		z         zoneAccess
+		owner     string
+		org       sql.NullInt64
+		member    sql.NullString
+		share     sql.NullString
+		subtree   string
+		types     []string
+		suspended bool
+	)
+	err := tx.QueryRowContext(ctx, "SELECT d.id, d.account, d.organization, m.role, s.role, COALESCE(s.subtree,''), s.types, COALESCE(o.suspended_at IS NOT NULL, false) FROM domains d LEFT JOIN accounts o ON o.id = d.account LEFT JOIN members m ON m.organization = d.organization AND m.account = $2 LEFT JOIN zone_shares s ON s.domain = d.id AND s.account = $2 WHERE d.name = $1;",
+		name, account).Scan(&z.ID, &owner, &org, &member, &share, &subtree, pq.Array(&types), &suspended)
+	if err == sql.ErrNoRows {
+		return nil, notFound("domain", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	z.Role = noRole
+	if !org.Valid && owner == account {
+		z.Role = pb.Role_Owner
+	}
+	if org.Valid && member.Valid {
+		z.Role = parseRole(member.String)
+	}
+	if z.Role < need && share.Valid && parseRole(share.String) >= need {
+		z.Role = parseRole(share.String)
+		z.Subtree = subtree
+		z.Types = types
+	}
+	if z.Role < need {
+		return nil, permissionDenied("domain", name)
+	}
+	if need > pb.Role_Viewer && !org.Valid && suspended {
+		return nil, failedPrecondition("domain", "the zone of a suspended account cannot be changed")
+	}
+	return &z, nil
+}
+
+// authorizeDomain returns the id of the domain if account holds at least
+// role need on it, see authorizeZone.
+func authorizeDomain(ctx context.Context, tx *sql.Tx, name string, account string, need pb.Role) (string, error) {
+	z, err := authorizeZone(ctx, tx, name, account, need)
+	if err != nil {
+		return "", err
+	}
+	return z.ID, nil
+}
+
+// requireRole returns the role of account in org if it is at least need.
+// Organizations are reported as not found to accounts outside of them.
+func requireRole(ctx context.Context, tx *sql.Tx, org int64, account string, need pb.Role) (pb.Role, error) {
+	var s string
+	err := tx.QueryRowContext(ctx, "SELECT role FROM members WHERE organization = $1 AND account = $2;", org, account).Scan(&s)
+	if err == sql.ErrNoRows {
+		return noRole, notFound("organization", strconv.FormatInt(org, 10))
+	}
+	if err != nil {
+		return noRole, err
+	}
+	role := parseRole(s)
+	if role < need {
+		return role, permissionDenied("organization", strconv.FormatInt(org, 10))
+	}
+	return role, nil
+}
+
+func (s *server) CreateOrganization(ctx context.Context, in *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
+	name := in.GetName()
+	if name == "" {
+		return nil, badRequest("name", errors.New("name is required"))
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var id int64
+	err = tx.QueryRowContext(ctx, "SELECT id FROM organizations WHERE name = $1;", name).Scan(&id)
+	if err == nil {
+		tx.Rollback()
+		return nil, alreadyExists("organization", name, "this organization name is already used")
+	}
+	if err != sql.ErrNoRows {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.QueryRowContext(ctx, "INSERT INTO organizations(name) VALUES ($1) RETURNING id;", name).Scan(&id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO members(organization,account,role) VALUES ($1,$2,$3);", id, a, pb.Role_Owner.String())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateOrganizationResponse{Status: pb.ResponseStatus_Ok, Organization: &pb.Organization{Id: id, Name: name, Role: pb.Role_Owner}}, nil
+}
+
+func (s *server) GetOrganizations(ctx context.Context, in *empty.Empty) (*pb.GetOrganizationsResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT o.id, o.name, m.role FROM organizations o JOIN members m ON m.organization = o.id WHERE m.account = $1 ORDER BY o.id;", a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	li := make([]*pb.Organization, 0, 4)
+	for rows.Next() {
+		var (
+			item = new(pb.Organization)
+			role string
+		)
+		err = rows.Scan(&item.Id, &item.Name, &role)
+		if err != nil {
+			rows.Close()
+			tx.Rollback()
+			return nil, err
+		}
+		item.Role = parseRole(role)
+		li = append(li, item)
+	}
+	rows.Close()
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetOrganizationsResponse{Status: pb.ResponseStatus_Ok, Organizations: li}, nil
+}
+
+func (s *server) GetMembers(ctx context.Context, in *pb.GetMembersRequest) (*pb.GetMembersResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = requireRole(ctx, tx, in.GetOrganization(), a, pb.Role_Viewer)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT a.email, m.role FROM members m JOIN accounts a ON a.id = m.account WHERE m.organization = $1 ORDER BY a.email;", in.GetOrganization())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	li := make([]*pb.Member, 0, 4)
+	for rows.Next() {
+		var (
+			item = new(pb.Member)
+			role string
+		)
+		err = rows.Scan(&item.Email, &role)
+		if err != nil {
+			rows.Close()
+			tx.Rollback()
+			return nil, err
+		}
+		item.Role = parseRole(role)
+		li = append(li, item)
+	}
+	rows.Close()
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMembersResponse{Status: pb.ResponseStatus_Ok, Members: li}, nil
+}
+
+func (s *server) InviteMember(ctx context.Context, in *pb.InviteMemberRequest) (*pb.InviteMemberResponse, error) {
+	if _, ok := pb.Role_name[int32(in.GetRole())]; !ok {
+		return nil, badRequest("role", errors.New("unknown role"))
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	role, err := requireRole(ctx, tx, in.GetOrganization(), a, pb.Role_Admin)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if in.GetRole() == pb.Role_Owner && role != pb.Role_Owner {
+		tx.Rollback()
+		return nil, permissionDenied("organization", strconv.FormatInt(in.GetOrganization(), 10))
+	}
+	var id string
+	err = tx.QueryRowContext(ctx, "SELECT id FROM accounts WHERE email = $1;", in.GetEmail()).Scan(&id)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return nil, notFound("account", in.GetEmail())
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var n int
+	err = tx.QueryRowContext(ctx, "SELECT count(*) FROM members WHERE organization = $1 AND account = $2;", in.GetOrganization(), id).Scan(&n)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if n != 0 {
+		tx.Rollback()
+		return nil, alreadyExists("member", in.GetEmail(), "this account is already a member")
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO members(organization,account,role) VALUES ($1,$2,$3);", in.GetOrganization(), id, in.GetRole().String())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InviteMemberResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
+// RemoveMember removes a member. Members may always leave, admins may
+// remove members below them, and owners may remove anyone. The last
+// owner cannot be removed.
+func (s *server) RemoveMember(ctx context.Context, in *pb.RemoveMemberRequest) (*pb.RemoveMemberResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	org := in.GetOrganization()
+	role, err := requireRole(ctx, tx, org, a, pb.Role_Viewer)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var (
+		id     string
+		target string
+	)
+	err = tx.QueryRowContext(ctx, "SELECT a.id, m.role FROM members m JOIN accounts a ON a.id = m.account WHERE m.organization = $1 AND a.email = $2;", org, in.GetEmail()).Scan(&id, &target)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return nil, notFound("member", in.GetEmail())
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if id != a && role != pb.Role_Owner && (role < pb.Role_Admin || parseRole(target) >= role) {
+		tx.Rollback()
+		return nil, permissionDenied("member", in.GetEmail())
+	}
+	if parseRole(target) == pb.Role_Owner {
+		var n int
+		err = tx.QueryRowContext(ctx, "SELECT count(*) FROM members WHERE organization = $1 AND role = $2;", org, pb.Role_Owner.String()).Scan(&n)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if n == 1 {
+			tx.Rollback()
+			return nil, failedPrecondition("organization", "the last owner cannot be removed")
+		}
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM members WHERE organization = $1 AND account = $2;", org, id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RemoveMemberResponse{Status: pb.ResponseStatus_Ok}, nil
+}
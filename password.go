@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxPasswordLength is the number of bytes bcrypt uses. Longer passwords
+// would be silently truncated.
+const maxPasswordLength = 72
+
+var breached map[string]bool
+
+// loadBreached builds the set of breached passwords from the bundled list
+// and the file at PASSWORD_BLOCKLIST, if set. Passwords shorter than
+// PASSWORD_MIN_LENGTH are skipped, as they are rejected anyway.
+func loadBreached() (map[string]bool, error) {
+	m := make(map[string]bool)
+	add := func(p string) {
+		if p = strings.TrimSpace(p); utf8.RuneCountInString(p) >= passwordMinLength {
+			m[strings.ToLower(p)] = true
+		}
+	}
+	for _, p := range strings.Split(breachedPasswords, "\n") {
+		add(p)
+	}
+	if passwordBlocklist == "" {
+		return m, nil
+	}
+	f, err := os.Open(passwordBlocklist)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		add(s.Text())
+	}
+	return m, s.Err()
+}
+
+// isBreached reports whether pass is on the breached password list,
+// ignoring case.
+func isBreached(pass string) bool {
+	return breached[strings.ToLower(pass)]
+}
+
+// charClasses counts the classes of lower case letters, upper case
+// letters, digits and other characters used in pass.
+func charClasses(pass string) int {
+	var lower, upper, digit, other int
+	for _, r := range pass {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+	return lower + upper + digit + other
+}
+
+// validatePassword checks pass against the password policy. email is the
+// account the password is for.
+func validatePassword(pass string, email string) error {
+	if pass == "" {
+		return errors.New("password is required")
+	}
+	if utf8.RuneCountInString(pass) < passwordMinLength {
+		return fmt.Errorf("password must be at least %d characters", passwordMinLength)
+	}
+	if len(pass) > maxPasswordLength {
+		return fmt.Errorf("password must be at most %d bytes", maxPasswordLength)
+	}
+	if charClasses(pass) < passwordMinClasses {
+		return fmt.Errorf("password must use %d of lower case letters, upper case letters, digits and symbols", passwordMinClasses)
+	}
+	lower := strings.ToLower(pass)
+	local := strings.ToLower(email)
+	if i := strings.LastIndex(local, "@"); i >= 0 {
+		local = local[:i]
+	}
+	if lower == strings.ToLower(email) || lower == local {
+		return errors.New("password must not be the email")
+	}
+	if isBreached(pass) {
+		return errors.New("password is known from data breaches")
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePassword(t *testing.T) {
+	var err error
+	breached, err = loadBreached()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, validatePassword("Change.Me-1", "mail@example.com"))
+	assert.NotEqual(t, nil, validatePassword("", "mail@example.com"))
+	assert.NotEqual(t, nil, validatePassword("Sh.ort-1", "mail@example.com"))
+	assert.NotEqual(t, nil, validatePassword("onlylowercase", "mail@example.com"))
+	assert.NotEqual(t, nil, validatePassword("P@ssw0rd123", "mail@example.com"))
+	assert.NotEqual(t, nil, validatePassword("Password123!", "mail@example.com"))
+	assert.NotEqual(t, nil, validatePassword("Mail@Example.com", "mail@example.com"))
+	assert.NotEqual(t, nil, validatePassword("Aa1."+string(make([]byte, 70)), "mail@example.com"))
+	assert.Equal(t, 4, charClasses("aZ9-"))
+}
@@ -25,6 +25,79 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// APIKeyScope limits what an API key may do. API keys can never manage
+// the account itself. The scope must be set, so a client which leaves it
+// out does not get a key with full access.
+type APIKeyScope int32
+
+const (
+	APIKeyScope_Unspecified APIKeyScope = 0
+	APIKeyScope_ReadOnly    APIKeyScope = 1
+	APIKeyScope_RecordsOnly APIKeyScope = 2
+	APIKeyScope_AcmeTXT     APIKeyScope = 3
+	APIKeyScope_Full        APIKeyScope = 4
+)
+
+var APIKeyScope_name = map[int32]string{
+	0: "Unspecified",
+	1: "ReadOnly",
+	2: "RecordsOnly",
+	3: "AcmeTXT",
+	4: "Full",
+}
+
+var APIKeyScope_value = map[string]int32{
+	"Unspecified": 0,
+	"ReadOnly":    1,
+	"RecordsOnly": 2,
+	"AcmeTXT":     3,
+	"Full":        4,
+}
+
+func (x APIKeyScope) String() string {
+	return proto.EnumName(APIKeyScope_name, int32(x))
+}
+
+func (APIKeyScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+// Role of a member in an organization. Each role includes the ones
+// before it: viewers read zones, editors change records, admins manage
+// zones and members, and owners manage admins and owners.
+type Role int32
+
+const (
+	Role_Viewer Role = 0
+	Role_Editor Role = 1
+	Role_Admin  Role = 2
+	Role_Owner  Role = 3
+)
+
+var Role_name = map[int32]string{
+	0: "Viewer",
+	1: "Editor",
+	2: "Admin",
+	3: "Owner",
+}
+
+var Role_value = map[string]int32{
+	"Viewer": 0,
+	"Editor": 1,
+	"Admin":  2,
+	"Owner":  3,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
+// ResponseStatus is Ok on success. Failures are reported as gRPC status
+// codes with google.rpc error details instead.
 type ResponseStatus int32
 
 const (
@@ -50,7 +123,7 @@ func (x ResponseStatus) String() string {
 }
 
 func (ResponseStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
 }
 
 type RRType int32
@@ -309,7 +382,7 @@ func (x RRType) String() string {
 }
 
 func (RRType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
 }
 
 type CreateAccountResponse_Status int32
@@ -343,6 +416,84 @@ func (CreateAccountResponse_Status) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{3, 0}
 }
 
+type ExportZoneRequest_Format int32
+
+const (
+	ExportZoneRequest_ZoneFile ExportZoneRequest_Format = 0
+	ExportZoneRequest_Json     ExportZoneRequest_Format = 1
+)
+
+var ExportZoneRequest_Format_name = map[int32]string{
+	0: "ZoneFile",
+	1: "Json",
+}
+
+var ExportZoneRequest_Format_value = map[string]int32{
+	"ZoneFile": 0,
+	"Json":     1,
+}
+
+func (x ExportZoneRequest_Format) String() string {
+	return proto.EnumName(ExportZoneRequest_Format_name, int32(x))
+}
+
+func (ExportZoneRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28, 0}
+}
+
+type RRSet_ChangeType int32
+
+const (
+	RRSet_REPLACE RRSet_ChangeType = 0
+	RRSet_DELETE  RRSet_ChangeType = 1
+)
+
+var RRSet_ChangeType_name = map[int32]string{
+	0: "REPLACE",
+	1: "DELETE",
+}
+
+var RRSet_ChangeType_value = map[string]int32{
+	"REPLACE": 0,
+	"DELETE":  1,
+}
+
+func (x RRSet_ChangeType) String() string {
+	return proto.EnumName(RRSet_ChangeType_name, int32(x))
+}
+
+func (RRSet_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31, 0}
+}
+
+type ZoneTransfer_State int32
+
+const (
+	ZoneTransfer_Pending   ZoneTransfer_State = 0
+	ZoneTransfer_Accepted  ZoneTransfer_State = 1
+	ZoneTransfer_Cancelled ZoneTransfer_State = 2
+)
+
+var ZoneTransfer_State_name = map[int32]string{
+	0: "Pending",
+	1: "Accepted",
+	2: "Cancelled",
+}
+
+var ZoneTransfer_State_value = map[string]int32{
+	"Pending":   0,
+	"Accepted":  1,
+	"Cancelled": 2,
+}
+
+func (x ZoneTransfer_State) String() string {
+	return proto.EnumName(ZoneTransfer_State_name, int32(x))
+}
+
+func (ZoneTransfer_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63, 0}
+}
+
 type Ping struct {
 	Text                 string   `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -471,6 +622,7 @@ func (m *CreateAccountRequest) GetPassword() string {
 type CreateAccountResponse struct {
 	Status               CreateAccountResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=api.CreateAccountResponse_Status" json:"status,omitempty"`
 	Token                string                       `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken         string                       `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -515,9 +667,19 @@ func (m *CreateAccountResponse) GetToken() string {
 	return ""
 }
 
+func (m *CreateAccountResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
 type GetTokenRequest struct {
-	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// otp is a TOTP code or a recovery code, required if two-factor
+	// authentication is enabled.
+	Otp                  string   `protobuf:"bytes,3,opt,name=otp,proto3" json:"otp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -562,9 +724,17 @@ func (m *GetTokenRequest) GetPassword() string {
 	return ""
 }
 
+func (m *GetTokenRequest) GetOtp() string {
+	if m != nil {
+		return m.Otp
+	}
+	return ""
+}
+
 type GetTokenResponse struct {
 	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
 	Token                string         `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken         string         `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -609,8 +779,17 @@ func (m *GetTokenResponse) GetToken() string {
 	return ""
 }
 
+func (m *GetTokenResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
 type ChangePasswordRequest struct {
-	Pass                 string   `protobuf:"bytes,1,opt,name=pass,proto3" json:"pass,omitempty"`
+	Pass string `protobuf:"bytes,1,opt,name=pass,proto3" json:"pass,omitempty"`
+	// current is the password being replaced.
+	Current              string   `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -648,8 +827,17 @@ func (m *ChangePasswordRequest) GetPass() string {
 	return ""
 }
 
+func (m *ChangePasswordRequest) GetCurrent() string {
+	if m != nil {
+		return m.Current
+	}
+	return ""
+}
+
 type ChangePasswordResponse struct {
 	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Token                string         `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken         string         `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -687,8 +875,27 @@ func (m *ChangePasswordResponse) GetStatus() ResponseStatus {
 	return ResponseStatus_Ok
 }
 
+func (m *ChangePasswordResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ChangePasswordResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
 type InitZoneRequest struct {
-	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// organization owns the zone if set, otherwise the caller does.
+	Organization int64 `protobuf:"varint,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	// verify creates the zone only after ownership of the domain is proven
+	// with verifyZone. It is always on if the server requires verification.
+	Verify               bool     `protobuf:"varint,3,opt,name=verify,proto3" json:"verify,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -726,8 +933,24 @@ func (m *InitZoneRequest) GetDomain() string {
 	return ""
 }
 
+func (m *InitZoneRequest) GetOrganization() int64 {
+	if m != nil {
+		return m.Organization
+	}
+	return 0
+}
+
+func (m *InitZoneRequest) GetVerify() bool {
+	if m != nil {
+		return m.Verify
+	}
+	return false
+}
+
 type InitZoneResponse struct {
-	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Status ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	// pending is set if the zone waits for verification.
+	Pending              *PendingDomain `protobuf:"bytes,2,opt,name=pending,proto3" json:"pending,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -765,6 +988,165 @@ func (m *InitZoneResponse) GetStatus() ResponseStatus {
 	return ResponseStatus_Ok
 }
 
+func (m *InitZoneResponse) GetPending() *PendingDomain {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+// PendingDomain is a claim on a domain. Publish challenge in a TXT record
+// at txtName, or delegate the domain to nameserver, then call verifyZone
+// before expiresAt (unix seconds).
+type PendingDomain struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Challenge            string   `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	TxtName              string   `protobuf:"bytes,3,opt,name=txtName,proto3" json:"txtName,omitempty"`
+	Nameserver           string   `protobuf:"bytes,4,opt,name=nameserver,proto3" json:"nameserver,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingDomain) Reset()         { *m = PendingDomain{} }
+func (m *PendingDomain) String() string { return proto.CompactTextString(m) }
+func (*PendingDomain) ProtoMessage()    {}
+func (*PendingDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+}
+
+func (m *PendingDomain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingDomain.Unmarshal(m, b)
+}
+func (m *PendingDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingDomain.Marshal(b, m, deterministic)
+}
+func (m *PendingDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingDomain.Merge(m, src)
+}
+func (m *PendingDomain) XXX_Size() int {
+	return xxx_messageInfo_PendingDomain.Size(m)
+}
+func (m *PendingDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingDomain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingDomain proto.InternalMessageInfo
+
+func (m *PendingDomain) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PendingDomain) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+func (m *PendingDomain) GetTxtName() string {
+	if m != nil {
+		return m.TxtName
+	}
+	return ""
+}
+
+func (m *PendingDomain) GetNameserver() string {
+	if m != nil {
+		return m.Nameserver
+	}
+	return ""
+}
+
+func (m *PendingDomain) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type VerifyZoneRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyZoneRequest) Reset()         { *m = VerifyZoneRequest{} }
+func (m *VerifyZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyZoneRequest) ProtoMessage()    {}
+func (*VerifyZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+}
+
+func (m *VerifyZoneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyZoneRequest.Unmarshal(m, b)
+}
+func (m *VerifyZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyZoneRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyZoneRequest.Merge(m, src)
+}
+func (m *VerifyZoneRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyZoneRequest.Size(m)
+}
+func (m *VerifyZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyZoneRequest proto.InternalMessageInfo
+
+func (m *VerifyZoneRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type VerifyZoneResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *VerifyZoneResponse) Reset()         { *m = VerifyZoneResponse{} }
+func (m *VerifyZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyZoneResponse) ProtoMessage()    {}
+func (*VerifyZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+}
+
+func (m *VerifyZoneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyZoneResponse.Unmarshal(m, b)
+}
+func (m *VerifyZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyZoneResponse.Marshal(b, m, deterministic)
+}
+func (m *VerifyZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyZoneResponse.Merge(m, src)
+}
+func (m *VerifyZoneResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyZoneResponse.Size(m)
+}
+func (m *VerifyZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyZoneResponse proto.InternalMessageInfo
+
+func (m *VerifyZoneResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
 type RemoveZoneRequest struct {
 	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -776,7 +1158,7 @@ func (m *RemoveZoneRequest) Reset()         { *m = RemoveZoneRequest{} }
 func (m *RemoveZoneRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveZoneRequest) ProtoMessage()    {}
 func (*RemoveZoneRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
 }
 
 func (m *RemoveZoneRequest) XXX_Unmarshal(b []byte) error {
@@ -815,7 +1197,7 @@ func (m *RemoveZoneResponse) Reset()         { *m = RemoveZoneResponse{} }
 func (m *RemoveZoneResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveZoneResponse) ProtoMessage()    {}
 func (*RemoveZoneResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
 }
 
 func (m *RemoveZoneResponse) XXX_Unmarshal(b []byte) error {
@@ -858,7 +1240,7 @@ func (m *AddRecordRequest) Reset()         { *m = AddRecordRequest{} }
 func (m *AddRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*AddRecordRequest) ProtoMessage()    {}
 func (*AddRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
 }
 
 func (m *AddRecordRequest) XXX_Unmarshal(b []byte) error {
@@ -925,7 +1307,7 @@ func (m *AddRecordResponse) Reset()         { *m = AddRecordResponse{} }
 func (m *AddRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*AddRecordResponse) ProtoMessage()    {}
 func (*AddRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
 
 func (m *AddRecordResponse) XXX_Unmarshal(b []byte) error {
@@ -967,7 +1349,7 @@ func (m *RemoveRecordRequest) Reset()         { *m = RemoveRecordRequest{} }
 func (m *RemoveRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveRecordRequest) ProtoMessage()    {}
 func (*RemoveRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
 }
 
 func (m *RemoveRecordRequest) XXX_Unmarshal(b []byte) error {
@@ -1027,7 +1409,7 @@ func (m *RemoveRecordResponse) Reset()         { *m = RemoveRecordResponse{} }
 func (m *RemoveRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveRecordResponse) ProtoMessage()    {}
 func (*RemoveRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 
 func (m *RemoveRecordResponse) XXX_Unmarshal(b []byte) error {
@@ -1068,7 +1450,7 @@ func (m *UpdateRecordRequest) Reset()         { *m = UpdateRecordRequest{} }
 func (m *UpdateRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRecordRequest) ProtoMessage()    {}
 func (*UpdateRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
 
 func (m *UpdateRecordRequest) XXX_Unmarshal(b []byte) error {
@@ -1123,7 +1505,7 @@ func (m *UpdateRecordRequest_Target) Reset()         { *m = UpdateRecordRequest_
 func (m *UpdateRecordRequest_Target) String() string { return proto.CompactTextString(m) }
 func (*UpdateRecordRequest_Target) ProtoMessage()    {}
 func (*UpdateRecordRequest_Target) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16, 0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19, 0}
 }
 
 func (m *UpdateRecordRequest_Target) XXX_Unmarshal(b []byte) error {
@@ -1179,7 +1561,7 @@ func (m *UpdateRecordRequest_Source) Reset()         { *m = UpdateRecordRequest_
 func (m *UpdateRecordRequest_Source) String() string { return proto.CompactTextString(m) }
 func (*UpdateRecordRequest_Source) ProtoMessage()    {}
 func (*UpdateRecordRequest_Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16, 1}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19, 1}
 }
 
 func (m *UpdateRecordRequest_Source) XXX_Unmarshal(b []byte) error {
@@ -1239,7 +1621,7 @@ func (m *UpdateRecordResponse) Reset()         { *m = UpdateRecordResponse{} }
 func (m *UpdateRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateRecordResponse) ProtoMessage()    {}
 func (*UpdateRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
 
 func (m *UpdateRecordResponse) XXX_Unmarshal(b []byte) error {
@@ -1279,7 +1661,7 @@ func (m *GetDomainsResponse) Reset()         { *m = GetDomainsResponse{} }
 func (m *GetDomainsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDomainsResponse) ProtoMessage()    {}
 func (*GetDomainsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
 }
 
 func (m *GetDomainsResponse) XXX_Unmarshal(b []byte) error {
@@ -1317,6 +1699,7 @@ func (m *GetDomainsResponse) GetDomains() []*Domain {
 type Domain struct {
 	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Organization         int64    `protobuf:"varint,3,opt,name=organization,proto3" json:"organization,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1326,7 +1709,7 @@ func (m *Domain) Reset()         { *m = Domain{} }
 func (m *Domain) String() string { return proto.CompactTextString(m) }
 func (*Domain) ProtoMessage()    {}
 func (*Domain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
 }
 
 func (m *Domain) XXX_Unmarshal(b []byte) error {
@@ -1361,6 +1744,13 @@ func (m *Domain) GetName() string {
 	return ""
 }
 
+func (m *Domain) GetOrganization() int64 {
+	if m != nil {
+		return m.Organization
+	}
+	return 0
+}
+
 type GetRecordsRequest struct {
 	Origin               string   `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1372,7 +1762,7 @@ func (m *GetRecordsRequest) Reset()         { *m = GetRecordsRequest{} }
 func (m *GetRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRecordsRequest) ProtoMessage()    {}
 func (*GetRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
 
 func (m *GetRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -1412,7 +1802,7 @@ func (m *GetRecordsResponse) Reset()         { *m = GetRecordsResponse{} }
 func (m *GetRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRecordsResponse) ProtoMessage()    {}
 func (*GetRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
 }
 
 func (m *GetRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -1461,7 +1851,7 @@ func (m *Record) Reset()         { *m = Record{} }
 func (m *Record) String() string { return proto.CompactTextString(m) }
 func (*Record) ProtoMessage()    {}
 func (*Record) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
 }
 
 func (m *Record) XXX_Unmarshal(b []byte) error {
@@ -1510,561 +1900,6862 @@ func (m *Record) GetContent() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("api.ResponseStatus", ResponseStatus_name, ResponseStatus_value)
-	proto.RegisterEnum("api.RRType", RRType_name, RRType_value)
-	proto.RegisterEnum("api.CreateAccountResponse_Status", CreateAccountResponse_Status_name, CreateAccountResponse_Status_value)
-	proto.RegisterType((*Ping)(nil), "api.Ping")
-	proto.RegisterType((*Pong)(nil), "api.Pong")
-	proto.RegisterType((*CreateAccountRequest)(nil), "api.CreateAccountRequest")
-	proto.RegisterType((*CreateAccountResponse)(nil), "api.CreateAccountResponse")
-	proto.RegisterType((*GetTokenRequest)(nil), "api.getTokenRequest")
-	proto.RegisterType((*GetTokenResponse)(nil), "api.getTokenResponse")
-	proto.RegisterType((*ChangePasswordRequest)(nil), "api.changePasswordRequest")
-	proto.RegisterType((*ChangePasswordResponse)(nil), "api.changePasswordResponse")
-	proto.RegisterType((*InitZoneRequest)(nil), "api.InitZoneRequest")
-	proto.RegisterType((*InitZoneResponse)(nil), "api.InitZoneResponse")
-	proto.RegisterType((*RemoveZoneRequest)(nil), "api.RemoveZoneRequest")
-	proto.RegisterType((*RemoveZoneResponse)(nil), "api.RemoveZoneResponse")
-	proto.RegisterType((*AddRecordRequest)(nil), "api.AddRecordRequest")
-	proto.RegisterType((*AddRecordResponse)(nil), "api.AddRecordResponse")
-	proto.RegisterType((*RemoveRecordRequest)(nil), "api.RemoveRecordRequest")
-	proto.RegisterType((*RemoveRecordResponse)(nil), "api.RemoveRecordResponse")
-	proto.RegisterType((*UpdateRecordRequest)(nil), "api.UpdateRecordRequest")
-	proto.RegisterType((*UpdateRecordRequest_Target)(nil), "api.UpdateRecordRequest.Target")
-	proto.RegisterType((*UpdateRecordRequest_Source)(nil), "api.UpdateRecordRequest.Source")
-	proto.RegisterType((*UpdateRecordResponse)(nil), "api.UpdateRecordResponse")
-	proto.RegisterType((*GetDomainsResponse)(nil), "api.GetDomainsResponse")
-	proto.RegisterType((*Domain)(nil), "api.Domain")
+type ImportZoneRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Zone                 string   `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	Organization         int64    `protobuf:"varint,3,opt,name=organization,proto3" json:"organization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportZoneRequest) Reset()         { *m = ImportZoneRequest{} }
+func (m *ImportZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportZoneRequest) ProtoMessage()    {}
+func (*ImportZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+}
+
+func (m *ImportZoneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportZoneRequest.Unmarshal(m, b)
+}
+func (m *ImportZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportZoneRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportZoneRequest.Merge(m, src)
+}
+func (m *ImportZoneRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportZoneRequest.Size(m)
+}
+func (m *ImportZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportZoneRequest proto.InternalMessageInfo
+
+func (m *ImportZoneRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ImportZoneRequest) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *ImportZoneRequest) GetOrganization() int64 {
+	if m != nil {
+		return m.Organization
+	}
+	return 0
+}
+
+type ImportZoneResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ImportZoneResponse) Reset()         { *m = ImportZoneResponse{} }
+func (m *ImportZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportZoneResponse) ProtoMessage()    {}
+func (*ImportZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+}
+
+func (m *ImportZoneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportZoneResponse.Unmarshal(m, b)
+}
+func (m *ImportZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportZoneResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportZoneResponse.Merge(m, src)
+}
+func (m *ImportZoneResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportZoneResponse.Size(m)
+}
+func (m *ImportZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportZoneResponse proto.InternalMessageInfo
+
+func (m *ImportZoneResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type ExportZoneRequest struct {
+	Origin               string                   `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	Format               ExportZoneRequest_Format `protobuf:"varint,2,opt,name=format,proto3,enum=api.ExportZoneRequest_Format" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExportZoneRequest) Reset()         { *m = ExportZoneRequest{} }
+func (m *ExportZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportZoneRequest) ProtoMessage()    {}
+func (*ExportZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+}
+
+func (m *ExportZoneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportZoneRequest.Unmarshal(m, b)
+}
+func (m *ExportZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportZoneRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportZoneRequest.Merge(m, src)
+}
+func (m *ExportZoneRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportZoneRequest.Size(m)
+}
+func (m *ExportZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportZoneRequest proto.InternalMessageInfo
+
+func (m *ExportZoneRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *ExportZoneRequest) GetFormat() ExportZoneRequest_Format {
+	if m != nil {
+		return m.Format
+	}
+	return ExportZoneRequest_ZoneFile
+}
+
+type ExportZoneResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Zone                 string         `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ExportZoneResponse) Reset()         { *m = ExportZoneResponse{} }
+func (m *ExportZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportZoneResponse) ProtoMessage()    {}
+func (*ExportZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+}
+
+func (m *ExportZoneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportZoneResponse.Unmarshal(m, b)
+}
+func (m *ExportZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportZoneResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportZoneResponse.Merge(m, src)
+}
+func (m *ExportZoneResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportZoneResponse.Size(m)
+}
+func (m *ExportZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportZoneResponse proto.InternalMessageInfo
+
+func (m *ExportZoneResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *ExportZoneResponse) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+type ApplyChangesRequest struct {
+	Origin               string   `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	Rrsets               []*RRSet `protobuf:"bytes,2,rep,name=rrsets,proto3" json:"rrsets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyChangesRequest) Reset()         { *m = ApplyChangesRequest{} }
+func (m *ApplyChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyChangesRequest) ProtoMessage()    {}
+func (*ApplyChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+}
+
+func (m *ApplyChangesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyChangesRequest.Unmarshal(m, b)
+}
+func (m *ApplyChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyChangesRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplyChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyChangesRequest.Merge(m, src)
+}
+func (m *ApplyChangesRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplyChangesRequest.Size(m)
+}
+func (m *ApplyChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyChangesRequest proto.InternalMessageInfo
+
+func (m *ApplyChangesRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *ApplyChangesRequest) GetRrsets() []*RRSet {
+	if m != nil {
+		return m.Rrsets
+	}
+	return nil
+}
+
+type RRSet struct {
+	Name                 string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 RRType           `protobuf:"varint,2,opt,name=type,proto3,enum=api.RRType" json:"type,omitempty"`
+	Ttl                  int64            `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Changetype           RRSet_ChangeType `protobuf:"varint,4,opt,name=changetype,proto3,enum=api.RRSet_ChangeType" json:"changetype,omitempty"`
+	Contents             []string         `protobuf:"bytes,5,rep,name=contents,proto3" json:"contents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RRSet) Reset()         { *m = RRSet{} }
+func (m *RRSet) String() string { return proto.CompactTextString(m) }
+func (*RRSet) ProtoMessage()    {}
+func (*RRSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
+}
+
+func (m *RRSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RRSet.Unmarshal(m, b)
+}
+func (m *RRSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RRSet.Marshal(b, m, deterministic)
+}
+func (m *RRSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RRSet.Merge(m, src)
+}
+func (m *RRSet) XXX_Size() int {
+	return xxx_messageInfo_RRSet.Size(m)
+}
+func (m *RRSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_RRSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RRSet proto.InternalMessageInfo
+
+func (m *RRSet) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RRSet) GetType() RRType {
+	if m != nil {
+		return m.Type
+	}
+	return RRType_A
+}
+
+func (m *RRSet) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *RRSet) GetChangetype() RRSet_ChangeType {
+	if m != nil {
+		return m.Changetype
+	}
+	return RRSet_REPLACE
+}
+
+func (m *RRSet) GetContents() []string {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+type ApplyChangesResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ApplyChangesResponse) Reset()         { *m = ApplyChangesResponse{} }
+func (m *ApplyChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplyChangesResponse) ProtoMessage()    {}
+func (*ApplyChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
+}
+
+func (m *ApplyChangesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyChangesResponse.Unmarshal(m, b)
+}
+func (m *ApplyChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyChangesResponse.Marshal(b, m, deterministic)
+}
+func (m *ApplyChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyChangesResponse.Merge(m, src)
+}
+func (m *ApplyChangesResponse) XXX_Size() int {
+	return xxx_messageInfo_ApplyChangesResponse.Size(m)
+}
+func (m *ApplyChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyChangesResponse proto.InternalMessageInfo
+
+func (m *ApplyChangesResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshTokenRequest) Reset()         { *m = RefreshTokenRequest{} }
+func (m *RefreshTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenRequest) ProtoMessage()    {}
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
+}
+
+func (m *RefreshTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenRequest.Unmarshal(m, b)
+}
+func (m *RefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenRequest.Merge(m, src)
+}
+func (m *RefreshTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenRequest.Size(m)
+}
+func (m *RefreshTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshTokenRequest proto.InternalMessageInfo
+
+func (m *RefreshTokenRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Token                string         `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken         string         `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RefreshTokenResponse) Reset()         { *m = RefreshTokenResponse{} }
+func (m *RefreshTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenResponse) ProtoMessage()    {}
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
+}
+
+func (m *RefreshTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenResponse.Unmarshal(m, b)
+}
+func (m *RefreshTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenResponse.Marshal(b, m, deterministic)
+}
+func (m *RefreshTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenResponse.Merge(m, src)
+}
+func (m *RefreshTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenResponse.Size(m)
+}
+func (m *RefreshTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshTokenResponse proto.InternalMessageInfo
+
+func (m *RefreshTokenResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *RefreshTokenResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *RefreshTokenResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Everywhere           bool     `protobuf:"varint,2,opt,name=everywhere,proto3" json:"everywhere,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogoutRequest) Reset()         { *m = LogoutRequest{} }
+func (m *LogoutRequest) String() string { return proto.CompactTextString(m) }
+func (*LogoutRequest) ProtoMessage()    {}
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
+}
+
+func (m *LogoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutRequest.Unmarshal(m, b)
+}
+func (m *LogoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutRequest.Marshal(b, m, deterministic)
+}
+func (m *LogoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutRequest.Merge(m, src)
+}
+func (m *LogoutRequest) XXX_Size() int {
+	return xxx_messageInfo_LogoutRequest.Size(m)
+}
+func (m *LogoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutRequest proto.InternalMessageInfo
+
+func (m *LogoutRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *LogoutRequest) GetEverywhere() bool {
+	if m != nil {
+		return m.Everywhere
+	}
+	return false
+}
+
+type LogoutResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *LogoutResponse) Reset()         { *m = LogoutResponse{} }
+func (m *LogoutResponse) String() string { return proto.CompactTextString(m) }
+func (*LogoutResponse) ProtoMessage()    {}
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+}
+
+func (m *LogoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutResponse.Unmarshal(m, b)
+}
+func (m *LogoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutResponse.Marshal(b, m, deterministic)
+}
+func (m *LogoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutResponse.Merge(m, src)
+}
+func (m *LogoutResponse) XXX_Size() int {
+	return xxx_messageInfo_LogoutResponse.Size(m)
+}
+func (m *LogoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutResponse proto.InternalMessageInfo
+
+func (m *LogoutResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+// JWK is a public key in JSON Web Key format (RFC 7517).
+type JWK struct {
+	Kty                  string   `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
+	Kid                  string   `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	Use                  string   `protobuf:"bytes,3,opt,name=use,proto3" json:"use,omitempty"`
+	Alg                  string   `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
+	N                    string   `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`
+	E                    string   `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`
+	Crv                  string   `protobuf:"bytes,7,opt,name=crv,proto3" json:"crv,omitempty"`
+	X                    string   `protobuf:"bytes,8,opt,name=x,proto3" json:"x,omitempty"`
+	Y                    string   `protobuf:"bytes,9,opt,name=y,proto3" json:"y,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JWK) Reset()         { *m = JWK{} }
+func (m *JWK) String() string { return proto.CompactTextString(m) }
+func (*JWK) ProtoMessage()    {}
+func (*JWK) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
+}
+
+func (m *JWK) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JWK.Unmarshal(m, b)
+}
+func (m *JWK) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JWK.Marshal(b, m, deterministic)
+}
+func (m *JWK) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JWK.Merge(m, src)
+}
+func (m *JWK) XXX_Size() int {
+	return xxx_messageInfo_JWK.Size(m)
+}
+func (m *JWK) XXX_DiscardUnknown() {
+	xxx_messageInfo_JWK.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JWK proto.InternalMessageInfo
+
+func (m *JWK) GetKty() string {
+	if m != nil {
+		return m.Kty
+	}
+	return ""
+}
+
+func (m *JWK) GetKid() string {
+	if m != nil {
+		return m.Kid
+	}
+	return ""
+}
+
+func (m *JWK) GetUse() string {
+	if m != nil {
+		return m.Use
+	}
+	return ""
+}
+
+func (m *JWK) GetAlg() string {
+	if m != nil {
+		return m.Alg
+	}
+	return ""
+}
+
+func (m *JWK) GetN() string {
+	if m != nil {
+		return m.N
+	}
+	return ""
+}
+
+func (m *JWK) GetE() string {
+	if m != nil {
+		return m.E
+	}
+	return ""
+}
+
+func (m *JWK) GetCrv() string {
+	if m != nil {
+		return m.Crv
+	}
+	return ""
+}
+
+func (m *JWK) GetX() string {
+	if m != nil {
+		return m.X
+	}
+	return ""
+}
+
+func (m *JWK) GetY() string {
+	if m != nil {
+		return m.Y
+	}
+	return ""
+}
+
+type GetJWKSResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Keys                 []*JWK         `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetJWKSResponse) Reset()         { *m = GetJWKSResponse{} }
+func (m *GetJWKSResponse) String() string { return proto.CompactTextString(m) }
+func (*GetJWKSResponse) ProtoMessage()    {}
+func (*GetJWKSResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+}
+
+func (m *GetJWKSResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJWKSResponse.Unmarshal(m, b)
+}
+func (m *GetJWKSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJWKSResponse.Marshal(b, m, deterministic)
+}
+func (m *GetJWKSResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJWKSResponse.Merge(m, src)
+}
+func (m *GetJWKSResponse) XXX_Size() int {
+	return xxx_messageInfo_GetJWKSResponse.Size(m)
+}
+func (m *GetJWKSResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJWKSResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJWKSResponse proto.InternalMessageInfo
+
+func (m *GetJWKSResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *GetJWKSResponse) GetKeys() []*JWK {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// APIKey describes an API key. Times are unix seconds, 0 means never.
+type APIKey struct {
+	Id                   int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scope                APIKeyScope `protobuf:"varint,3,opt,name=scope,proto3,enum=api.APIKeyScope" json:"scope,omitempty"`
+	Zones                []string    `protobuf:"bytes,4,rep,name=zones,proto3" json:"zones,omitempty"`
+	ExpiresAt            int64       `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	LastUsedAt           int64       `protobuf:"varint,6,opt,name=lastUsedAt,proto3" json:"lastUsedAt,omitempty"`
+	CreatedAt            int64       `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Revoked              bool        `protobuf:"varint,8,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *APIKey) Reset()         { *m = APIKey{} }
+func (m *APIKey) String() string { return proto.CompactTextString(m) }
+func (*APIKey) ProtoMessage()    {}
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
+}
+
+func (m *APIKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKey.Unmarshal(m, b)
+}
+func (m *APIKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKey.Marshal(b, m, deterministic)
+}
+func (m *APIKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKey.Merge(m, src)
+}
+func (m *APIKey) XXX_Size() int {
+	return xxx_messageInfo_APIKey.Size(m)
+}
+func (m *APIKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKey proto.InternalMessageInfo
+
+func (m *APIKey) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *APIKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *APIKey) GetScope() APIKeyScope {
+	if m != nil {
+		return m.Scope
+	}
+	return APIKeyScope_Unspecified
+}
+
+func (m *APIKey) GetZones() []string {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+func (m *APIKey) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *APIKey) GetLastUsedAt() int64 {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return 0
+}
+
+func (m *APIKey) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *APIKey) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+type CreateAPIKeyRequest struct {
+	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scope APIKeyScope `protobuf:"varint,2,opt,name=scope,proto3,enum=api.APIKeyScope" json:"scope,omitempty"`
+	// zones restricts the key to these zones, empty means every zone.
+	Zones []string `protobuf:"bytes,3,rep,name=zones,proto3" json:"zones,omitempty"`
+	// ttl is the lifetime in seconds, 0 means the key does not expire.
+	Ttl                  int64    `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAPIKeyRequest) Reset()         { *m = CreateAPIKeyRequest{} }
+func (m *CreateAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAPIKeyRequest) ProtoMessage()    {}
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
+}
+
+func (m *CreateAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *CreateAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPIKeyRequest.Merge(m, src)
+}
+func (m *CreateAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAPIKeyRequest.Size(m)
+}
+func (m *CreateAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAPIKeyRequest proto.InternalMessageInfo
+
+func (m *CreateAPIKeyRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateAPIKeyRequest) GetScope() APIKeyScope {
+	if m != nil {
+		return m.Scope
+	}
+	return APIKeyScope_Unspecified
+}
+
+func (m *CreateAPIKeyRequest) GetZones() []string {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+func (m *CreateAPIKeyRequest) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+type CreateAPIKeyResponse struct {
+	Status ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Key    *APIKey        `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// secret is only returned once, send it in the apikey metadata.
+	Secret               string   `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAPIKeyResponse) Reset()         { *m = CreateAPIKeyResponse{} }
+func (m *CreateAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAPIKeyResponse) ProtoMessage()    {}
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+}
+
+func (m *CreateAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *CreateAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPIKeyResponse.Merge(m, src)
+}
+func (m *CreateAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAPIKeyResponse.Size(m)
+}
+func (m *CreateAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAPIKeyResponse proto.InternalMessageInfo
+
+func (m *CreateAPIKeyResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *CreateAPIKeyResponse) GetKey() *APIKey {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *CreateAPIKeyResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type ListAPIKeysResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Keys                 []*APIKey      `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListAPIKeysResponse) Reset()         { *m = ListAPIKeysResponse{} }
+func (m *ListAPIKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAPIKeysResponse) ProtoMessage()    {}
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+}
+
+func (m *ListAPIKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAPIKeysResponse.Unmarshal(m, b)
+}
+func (m *ListAPIKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAPIKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAPIKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAPIKeysResponse.Merge(m, src)
+}
+func (m *ListAPIKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAPIKeysResponse.Size(m)
+}
+func (m *ListAPIKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAPIKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAPIKeysResponse proto.InternalMessageInfo
+
+func (m *ListAPIKeysResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *ListAPIKeysResponse) GetKeys() []*APIKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPIKeyRequest) Reset()         { *m = RevokeAPIKeyRequest{} }
+func (m *RevokeAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyRequest) ProtoMessage()    {}
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+}
+
+func (m *RevokeAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyRequest.Merge(m, src)
+}
+func (m *RevokeAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Size(m)
+}
+func (m *RevokeAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeAPIKeyRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RevokeAPIKeyResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RevokeAPIKeyResponse) Reset()         { *m = RevokeAPIKeyResponse{} }
+func (m *RevokeAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyResponse) ProtoMessage()    {}
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+}
+
+func (m *RevokeAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyResponse.Merge(m, src)
+}
+func (m *RevokeAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Size(m)
+}
+func (m *RevokeAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyResponse proto.InternalMessageInfo
+
+func (m *RevokeAPIKeyResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type Organization struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role                 Role     `protobuf:"varint,3,opt,name=role,proto3,enum=api.Role" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Organization) Reset()         { *m = Organization{} }
+func (m *Organization) String() string { return proto.CompactTextString(m) }
+func (*Organization) ProtoMessage()    {}
+func (*Organization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+}
+
+func (m *Organization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Organization.Unmarshal(m, b)
+}
+func (m *Organization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Organization.Marshal(b, m, deterministic)
+}
+func (m *Organization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Organization.Merge(m, src)
+}
+func (m *Organization) XXX_Size() int {
+	return xxx_messageInfo_Organization.Size(m)
+}
+func (m *Organization) XXX_DiscardUnknown() {
+	xxx_messageInfo_Organization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Organization proto.InternalMessageInfo
+
+func (m *Organization) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Organization) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Organization) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_Viewer
+}
+
+type Member struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role                 Role     `protobuf:"varint,2,opt,name=role,proto3,enum=api.Role" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Member) Reset()         { *m = Member{} }
+func (m *Member) String() string { return proto.CompactTextString(m) }
+func (*Member) ProtoMessage()    {}
+func (*Member) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+}
+
+func (m *Member) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Member.Unmarshal(m, b)
+}
+func (m *Member) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Member.Marshal(b, m, deterministic)
+}
+func (m *Member) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Member.Merge(m, src)
+}
+func (m *Member) XXX_Size() int {
+	return xxx_messageInfo_Member.Size(m)
+}
+func (m *Member) XXX_DiscardUnknown() {
+	xxx_messageInfo_Member.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Member proto.InternalMessageInfo
+
+func (m *Member) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Member) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_Viewer
+}
+
+type CreateOrganizationRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateOrganizationRequest) Reset()         { *m = CreateOrganizationRequest{} }
+func (m *CreateOrganizationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateOrganizationRequest) ProtoMessage()    {}
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+}
+
+func (m *CreateOrganizationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOrganizationRequest.Unmarshal(m, b)
+}
+func (m *CreateOrganizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOrganizationRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateOrganizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrganizationRequest.Merge(m, src)
+}
+func (m *CreateOrganizationRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateOrganizationRequest.Size(m)
+}
+func (m *CreateOrganizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrganizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOrganizationRequest proto.InternalMessageInfo
+
+func (m *CreateOrganizationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CreateOrganizationResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Organization         *Organization  `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CreateOrganizationResponse) Reset()         { *m = CreateOrganizationResponse{} }
+func (m *CreateOrganizationResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateOrganizationResponse) ProtoMessage()    {}
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+}
+
+func (m *CreateOrganizationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOrganizationResponse.Unmarshal(m, b)
+}
+func (m *CreateOrganizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOrganizationResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateOrganizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrganizationResponse.Merge(m, src)
+}
+func (m *CreateOrganizationResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateOrganizationResponse.Size(m)
+}
+func (m *CreateOrganizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrganizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOrganizationResponse proto.InternalMessageInfo
+
+func (m *CreateOrganizationResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *CreateOrganizationResponse) GetOrganization() *Organization {
+	if m != nil {
+		return m.Organization
+	}
+	return nil
+}
+
+type GetOrganizationsResponse struct {
+	Status               ResponseStatus  `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Organizations        []*Organization `protobuf:"bytes,2,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetOrganizationsResponse) Reset()         { *m = GetOrganizationsResponse{} }
+func (m *GetOrganizationsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrganizationsResponse) ProtoMessage()    {}
+func (*GetOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+}
+
+func (m *GetOrganizationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrganizationsResponse.Unmarshal(m, b)
+}
+func (m *GetOrganizationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrganizationsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOrganizationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrganizationsResponse.Merge(m, src)
+}
+func (m *GetOrganizationsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrganizationsResponse.Size(m)
+}
+func (m *GetOrganizationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrganizationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrganizationsResponse proto.InternalMessageInfo
+
+func (m *GetOrganizationsResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *GetOrganizationsResponse) GetOrganizations() []*Organization {
+	if m != nil {
+		return m.Organizations
+	}
+	return nil
+}
+
+type GetMembersRequest struct {
+	Organization         int64    `protobuf:"varint,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMembersRequest) Reset()         { *m = GetMembersRequest{} }
+func (m *GetMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMembersRequest) ProtoMessage()    {}
+func (*GetMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+}
+
+func (m *GetMembersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMembersRequest.Unmarshal(m, b)
+}
+func (m *GetMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMembersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMembersRequest.Merge(m, src)
+}
+func (m *GetMembersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMembersRequest.Size(m)
+}
+func (m *GetMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMembersRequest proto.InternalMessageInfo
+
+func (m *GetMembersRequest) GetOrganization() int64 {
+	if m != nil {
+		return m.Organization
+	}
+	return 0
+}
+
+type GetMembersResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Members              []*Member      `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetMembersResponse) Reset()         { *m = GetMembersResponse{} }
+func (m *GetMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMembersResponse) ProtoMessage()    {}
+func (*GetMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+}
+
+func (m *GetMembersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMembersResponse.Unmarshal(m, b)
+}
+func (m *GetMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMembersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMembersResponse.Merge(m, src)
+}
+func (m *GetMembersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMembersResponse.Size(m)
+}
+func (m *GetMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMembersResponse proto.InternalMessageInfo
+
+func (m *GetMembersResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *GetMembersResponse) GetMembers() []*Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type InviteMemberRequest struct {
+	Organization         int64    `protobuf:"varint,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role                 Role     `protobuf:"varint,3,opt,name=role,proto3,enum=api.Role" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InviteMemberRequest) Reset()         { *m = InviteMemberRequest{} }
+func (m *InviteMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*InviteMemberRequest) ProtoMessage()    {}
+func (*InviteMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+}
+
+func (m *InviteMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteMemberRequest.Unmarshal(m, b)
+}
+func (m *InviteMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *InviteMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteMemberRequest.Merge(m, src)
+}
+func (m *InviteMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_InviteMemberRequest.Size(m)
+}
+func (m *InviteMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteMemberRequest proto.InternalMessageInfo
+
+func (m *InviteMemberRequest) GetOrganization() int64 {
+	if m != nil {
+		return m.Organization
+	}
+	return 0
+}
+
+func (m *InviteMemberRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *InviteMemberRequest) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_Viewer
+}
+
+type InviteMemberResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *InviteMemberResponse) Reset()         { *m = InviteMemberResponse{} }
+func (m *InviteMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*InviteMemberResponse) ProtoMessage()    {}
+func (*InviteMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+}
+
+func (m *InviteMemberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteMemberResponse.Unmarshal(m, b)
+}
+func (m *InviteMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteMemberResponse.Marshal(b, m, deterministic)
+}
+func (m *InviteMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteMemberResponse.Merge(m, src)
+}
+func (m *InviteMemberResponse) XXX_Size() int {
+	return xxx_messageInfo_InviteMemberResponse.Size(m)
+}
+func (m *InviteMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteMemberResponse proto.InternalMessageInfo
+
+func (m *InviteMemberResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type RemoveMemberRequest struct {
+	Organization         int64    `protobuf:"varint,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveMemberRequest) Reset()         { *m = RemoveMemberRequest{} }
+func (m *RemoveMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveMemberRequest) ProtoMessage()    {}
+func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
+}
+
+func (m *RemoveMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveMemberRequest.Unmarshal(m, b)
+}
+func (m *RemoveMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveMemberRequest.Merge(m, src)
+}
+func (m *RemoveMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveMemberRequest.Size(m)
+}
+func (m *RemoveMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveMemberRequest proto.InternalMessageInfo
+
+func (m *RemoveMemberRequest) GetOrganization() int64 {
+	if m != nil {
+		return m.Organization
+	}
+	return 0
+}
+
+func (m *RemoveMemberRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type RemoveMemberResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RemoveMemberResponse) Reset()         { *m = RemoveMemberResponse{} }
+func (m *RemoveMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveMemberResponse) ProtoMessage()    {}
+func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
+}
+
+func (m *RemoveMemberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveMemberResponse.Unmarshal(m, b)
+}
+func (m *RemoveMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveMemberResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveMemberResponse.Merge(m, src)
+}
+func (m *RemoveMemberResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveMemberResponse.Size(m)
+}
+func (m *RemoveMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveMemberResponse proto.InternalMessageInfo
+
+func (m *RemoveMemberResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+// ZoneShare grants an account outside the owners a role on one zone.
+// Only Viewer and Editor can be granted. subtree and types limit the
+// records the role applies to, empty means no limit.
+type ZoneShare struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role                 Role     `protobuf:"varint,2,opt,name=role,proto3,enum=api.Role" json:"role,omitempty"`
+	Subtree              string   `protobuf:"bytes,3,opt,name=subtree,proto3" json:"subtree,omitempty"`
+	Types                []RRType `protobuf:"varint,4,rep,packed,name=types,proto3,enum=api.RRType" json:"types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZoneShare) Reset()         { *m = ZoneShare{} }
+func (m *ZoneShare) String() string { return proto.CompactTextString(m) }
+func (*ZoneShare) ProtoMessage()    {}
+func (*ZoneShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
+}
+
+func (m *ZoneShare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZoneShare.Unmarshal(m, b)
+}
+func (m *ZoneShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZoneShare.Marshal(b, m, deterministic)
+}
+func (m *ZoneShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneShare.Merge(m, src)
+}
+func (m *ZoneShare) XXX_Size() int {
+	return xxx_messageInfo_ZoneShare.Size(m)
+}
+func (m *ZoneShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneShare proto.InternalMessageInfo
+
+func (m *ZoneShare) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *ZoneShare) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_Viewer
+}
+
+func (m *ZoneShare) GetSubtree() string {
+	if m != nil {
+		return m.Subtree
+	}
+	return ""
+}
+
+func (m *ZoneShare) GetTypes() []RRType {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+type ShareZoneRequest struct {
+	Domain               string     `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Share                *ZoneShare `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ShareZoneRequest) Reset()         { *m = ShareZoneRequest{} }
+func (m *ShareZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*ShareZoneRequest) ProtoMessage()    {}
+func (*ShareZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
+}
+
+func (m *ShareZoneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShareZoneRequest.Unmarshal(m, b)
+}
+func (m *ShareZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShareZoneRequest.Marshal(b, m, deterministic)
+}
+func (m *ShareZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareZoneRequest.Merge(m, src)
+}
+func (m *ShareZoneRequest) XXX_Size() int {
+	return xxx_messageInfo_ShareZoneRequest.Size(m)
+}
+func (m *ShareZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareZoneRequest proto.InternalMessageInfo
+
+func (m *ShareZoneRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ShareZoneRequest) GetShare() *ZoneShare {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+type ShareZoneResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ShareZoneResponse) Reset()         { *m = ShareZoneResponse{} }
+func (m *ShareZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*ShareZoneResponse) ProtoMessage()    {}
+func (*ShareZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
+}
+
+func (m *ShareZoneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShareZoneResponse.Unmarshal(m, b)
+}
+func (m *ShareZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShareZoneResponse.Marshal(b, m, deterministic)
+}
+func (m *ShareZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareZoneResponse.Merge(m, src)
+}
+func (m *ShareZoneResponse) XXX_Size() int {
+	return xxx_messageInfo_ShareZoneResponse.Size(m)
+}
+func (m *ShareZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareZoneResponse proto.InternalMessageInfo
+
+func (m *ShareZoneResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type UnshareZoneRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnshareZoneRequest) Reset()         { *m = UnshareZoneRequest{} }
+func (m *UnshareZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*UnshareZoneRequest) ProtoMessage()    {}
+func (*UnshareZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
+}
+
+func (m *UnshareZoneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnshareZoneRequest.Unmarshal(m, b)
+}
+func (m *UnshareZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnshareZoneRequest.Marshal(b, m, deterministic)
+}
+func (m *UnshareZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnshareZoneRequest.Merge(m, src)
+}
+func (m *UnshareZoneRequest) XXX_Size() int {
+	return xxx_messageInfo_UnshareZoneRequest.Size(m)
+}
+func (m *UnshareZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnshareZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnshareZoneRequest proto.InternalMessageInfo
+
+func (m *UnshareZoneRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *UnshareZoneRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type UnshareZoneResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UnshareZoneResponse) Reset()         { *m = UnshareZoneResponse{} }
+func (m *UnshareZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*UnshareZoneResponse) ProtoMessage()    {}
+func (*UnshareZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
+}
+
+func (m *UnshareZoneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnshareZoneResponse.Unmarshal(m, b)
+}
+func (m *UnshareZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnshareZoneResponse.Marshal(b, m, deterministic)
+}
+func (m *UnshareZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnshareZoneResponse.Merge(m, src)
+}
+func (m *UnshareZoneResponse) XXX_Size() int {
+	return xxx_messageInfo_UnshareZoneResponse.Size(m)
+}
+func (m *UnshareZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnshareZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnshareZoneResponse proto.InternalMessageInfo
+
+func (m *UnshareZoneResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type ListZoneSharesRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListZoneSharesRequest) Reset()         { *m = ListZoneSharesRequest{} }
+func (m *ListZoneSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListZoneSharesRequest) ProtoMessage()    {}
+func (*ListZoneSharesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
+}
+
+func (m *ListZoneSharesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListZoneSharesRequest.Unmarshal(m, b)
+}
+func (m *ListZoneSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListZoneSharesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListZoneSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListZoneSharesRequest.Merge(m, src)
+}
+func (m *ListZoneSharesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListZoneSharesRequest.Size(m)
+}
+func (m *ListZoneSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListZoneSharesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListZoneSharesRequest proto.InternalMessageInfo
+
+func (m *ListZoneSharesRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type ListZoneSharesResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Shares               []*ZoneShare   `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListZoneSharesResponse) Reset()         { *m = ListZoneSharesResponse{} }
+func (m *ListZoneSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListZoneSharesResponse) ProtoMessage()    {}
+func (*ListZoneSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+}
+
+func (m *ListZoneSharesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListZoneSharesResponse.Unmarshal(m, b)
+}
+func (m *ListZoneSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListZoneSharesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListZoneSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListZoneSharesResponse.Merge(m, src)
+}
+func (m *ListZoneSharesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListZoneSharesResponse.Size(m)
+}
+func (m *ListZoneSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListZoneSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListZoneSharesResponse proto.InternalMessageInfo
+
+func (m *ListZoneSharesResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *ListZoneSharesResponse) GetShares() []*ZoneShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// ZoneTransfer moves a zone to another account. Times are unix seconds,
+// 0 means never.
+type ZoneTransfer struct {
+	Id                   int64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domain               string             `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	From                 string             `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string             `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	State                ZoneTransfer_State `protobuf:"varint,5,opt,name=state,proto3,enum=api.ZoneTransfer_State" json:"state,omitempty"`
+	CreatedAt            int64              `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	CompletedAt          int64              `protobuf:"varint,7,opt,name=completedAt,proto3" json:"completedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ZoneTransfer) Reset()         { *m = ZoneTransfer{} }
+func (m *ZoneTransfer) String() string { return proto.CompactTextString(m) }
+func (*ZoneTransfer) ProtoMessage()    {}
+func (*ZoneTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
+}
+
+func (m *ZoneTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZoneTransfer.Unmarshal(m, b)
+}
+func (m *ZoneTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZoneTransfer.Marshal(b, m, deterministic)
+}
+func (m *ZoneTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneTransfer.Merge(m, src)
+}
+func (m *ZoneTransfer) XXX_Size() int {
+	return xxx_messageInfo_ZoneTransfer.Size(m)
+}
+func (m *ZoneTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneTransfer proto.InternalMessageInfo
+
+func (m *ZoneTransfer) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ZoneTransfer) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ZoneTransfer) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ZoneTransfer) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ZoneTransfer) GetState() ZoneTransfer_State {
+	if m != nil {
+		return m.State
+	}
+	return ZoneTransfer_Pending
+}
+
+func (m *ZoneTransfer) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ZoneTransfer) GetCompletedAt() int64 {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return 0
+}
+
+type TransferZoneRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransferZoneRequest) Reset()         { *m = TransferZoneRequest{} }
+func (m *TransferZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferZoneRequest) ProtoMessage()    {}
+func (*TransferZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
+}
+
+func (m *TransferZoneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferZoneRequest.Unmarshal(m, b)
+}
+func (m *TransferZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferZoneRequest.Marshal(b, m, deterministic)
+}
+func (m *TransferZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferZoneRequest.Merge(m, src)
+}
+func (m *TransferZoneRequest) XXX_Size() int {
+	return xxx_messageInfo_TransferZoneRequest.Size(m)
+}
+func (m *TransferZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferZoneRequest proto.InternalMessageInfo
+
+func (m *TransferZoneRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *TransferZoneRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type TransferZoneResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Transfer             *ZoneTransfer  `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TransferZoneResponse) Reset()         { *m = TransferZoneResponse{} }
+func (m *TransferZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*TransferZoneResponse) ProtoMessage()    {}
+func (*TransferZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+}
+
+func (m *TransferZoneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferZoneResponse.Unmarshal(m, b)
+}
+func (m *TransferZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferZoneResponse.Marshal(b, m, deterministic)
+}
+func (m *TransferZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferZoneResponse.Merge(m, src)
+}
+func (m *TransferZoneResponse) XXX_Size() int {
+	return xxx_messageInfo_TransferZoneResponse.Size(m)
+}
+func (m *TransferZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferZoneResponse proto.InternalMessageInfo
+
+func (m *TransferZoneResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *TransferZoneResponse) GetTransfer() *ZoneTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return nil
+}
+
+type AcceptZoneTransferRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptZoneTransferRequest) Reset()         { *m = AcceptZoneTransferRequest{} }
+func (m *AcceptZoneTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptZoneTransferRequest) ProtoMessage()    {}
+func (*AcceptZoneTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+}
+
+func (m *AcceptZoneTransferRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptZoneTransferRequest.Unmarshal(m, b)
+}
+func (m *AcceptZoneTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptZoneTransferRequest.Marshal(b, m, deterministic)
+}
+func (m *AcceptZoneTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptZoneTransferRequest.Merge(m, src)
+}
+func (m *AcceptZoneTransferRequest) XXX_Size() int {
+	return xxx_messageInfo_AcceptZoneTransferRequest.Size(m)
+}
+func (m *AcceptZoneTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptZoneTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptZoneTransferRequest proto.InternalMessageInfo
+
+func (m *AcceptZoneTransferRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type AcceptZoneTransferResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AcceptZoneTransferResponse) Reset()         { *m = AcceptZoneTransferResponse{} }
+func (m *AcceptZoneTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*AcceptZoneTransferResponse) ProtoMessage()    {}
+func (*AcceptZoneTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
+}
+
+func (m *AcceptZoneTransferResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptZoneTransferResponse.Unmarshal(m, b)
+}
+func (m *AcceptZoneTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptZoneTransferResponse.Marshal(b, m, deterministic)
+}
+func (m *AcceptZoneTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptZoneTransferResponse.Merge(m, src)
+}
+func (m *AcceptZoneTransferResponse) XXX_Size() int {
+	return xxx_messageInfo_AcceptZoneTransferResponse.Size(m)
+}
+func (m *AcceptZoneTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptZoneTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptZoneTransferResponse proto.InternalMessageInfo
+
+func (m *AcceptZoneTransferResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type CancelZoneTransferRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelZoneTransferRequest) Reset()         { *m = CancelZoneTransferRequest{} }
+func (m *CancelZoneTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelZoneTransferRequest) ProtoMessage()    {}
+func (*CancelZoneTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
+}
+
+func (m *CancelZoneTransferRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelZoneTransferRequest.Unmarshal(m, b)
+}
+func (m *CancelZoneTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelZoneTransferRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelZoneTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelZoneTransferRequest.Merge(m, src)
+}
+func (m *CancelZoneTransferRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelZoneTransferRequest.Size(m)
+}
+func (m *CancelZoneTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelZoneTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelZoneTransferRequest proto.InternalMessageInfo
+
+func (m *CancelZoneTransferRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type CancelZoneTransferResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CancelZoneTransferResponse) Reset()         { *m = CancelZoneTransferResponse{} }
+func (m *CancelZoneTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelZoneTransferResponse) ProtoMessage()    {}
+func (*CancelZoneTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
+}
+
+func (m *CancelZoneTransferResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelZoneTransferResponse.Unmarshal(m, b)
+}
+func (m *CancelZoneTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelZoneTransferResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelZoneTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelZoneTransferResponse.Merge(m, src)
+}
+func (m *CancelZoneTransferResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelZoneTransferResponse.Size(m)
+}
+func (m *CancelZoneTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelZoneTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelZoneTransferResponse proto.InternalMessageInfo
+
+func (m *CancelZoneTransferResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type ListZoneTransfersResponse struct {
+	Status               ResponseStatus  `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Transfers            []*ZoneTransfer `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListZoneTransfersResponse) Reset()         { *m = ListZoneTransfersResponse{} }
+func (m *ListZoneTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListZoneTransfersResponse) ProtoMessage()    {}
+func (*ListZoneTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
+}
+
+func (m *ListZoneTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListZoneTransfersResponse.Unmarshal(m, b)
+}
+func (m *ListZoneTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListZoneTransfersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListZoneTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListZoneTransfersResponse.Merge(m, src)
+}
+func (m *ListZoneTransfersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListZoneTransfersResponse.Size(m)
+}
+func (m *ListZoneTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListZoneTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListZoneTransfersResponse proto.InternalMessageInfo
+
+func (m *ListZoneTransfersResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *ListZoneTransfersResponse) GetTransfers() []*ZoneTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+type EnrollTOTPResponse struct {
+	Status ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	// secret is base32 encoded, uri is an otpauth:// URI for QR codes.
+	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Uri                  string   `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnrollTOTPResponse) Reset()         { *m = EnrollTOTPResponse{} }
+func (m *EnrollTOTPResponse) String() string { return proto.CompactTextString(m) }
+func (*EnrollTOTPResponse) ProtoMessage()    {}
+func (*EnrollTOTPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
+}
+
+func (m *EnrollTOTPResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnrollTOTPResponse.Unmarshal(m, b)
+}
+func (m *EnrollTOTPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnrollTOTPResponse.Marshal(b, m, deterministic)
+}
+func (m *EnrollTOTPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnrollTOTPResponse.Merge(m, src)
+}
+func (m *EnrollTOTPResponse) XXX_Size() int {
+	return xxx_messageInfo_EnrollTOTPResponse.Size(m)
+}
+func (m *EnrollTOTPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnrollTOTPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnrollTOTPResponse proto.InternalMessageInfo
+
+func (m *EnrollTOTPResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *EnrollTOTPResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *EnrollTOTPResponse) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+type ConfirmTOTPRequest struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmTOTPRequest) Reset()         { *m = ConfirmTOTPRequest{} }
+func (m *ConfirmTOTPRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmTOTPRequest) ProtoMessage()    {}
+func (*ConfirmTOTPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
+}
+
+func (m *ConfirmTOTPRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmTOTPRequest.Unmarshal(m, b)
+}
+func (m *ConfirmTOTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmTOTPRequest.Marshal(b, m, deterministic)
+}
+func (m *ConfirmTOTPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmTOTPRequest.Merge(m, src)
+}
+func (m *ConfirmTOTPRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfirmTOTPRequest.Size(m)
+}
+func (m *ConfirmTOTPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmTOTPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmTOTPRequest proto.InternalMessageInfo
+
+func (m *ConfirmTOTPRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type ConfirmTOTPResponse struct {
+	Status ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	// recoveryCodes can each be used once instead of a TOTP code.
+	RecoveryCodes        []string `protobuf:"bytes,2,rep,name=recoveryCodes,proto3" json:"recoveryCodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmTOTPResponse) Reset()         { *m = ConfirmTOTPResponse{} }
+func (m *ConfirmTOTPResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfirmTOTPResponse) ProtoMessage()    {}
+func (*ConfirmTOTPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
+}
+
+func (m *ConfirmTOTPResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmTOTPResponse.Unmarshal(m, b)
+}
+func (m *ConfirmTOTPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmTOTPResponse.Marshal(b, m, deterministic)
+}
+func (m *ConfirmTOTPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmTOTPResponse.Merge(m, src)
+}
+func (m *ConfirmTOTPResponse) XXX_Size() int {
+	return xxx_messageInfo_ConfirmTOTPResponse.Size(m)
+}
+func (m *ConfirmTOTPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmTOTPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmTOTPResponse proto.InternalMessageInfo
+
+func (m *ConfirmTOTPResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *ConfirmTOTPResponse) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableTOTPRequest struct {
+	// code is a TOTP code or a recovery code.
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableTOTPRequest) Reset()         { *m = DisableTOTPRequest{} }
+func (m *DisableTOTPRequest) String() string { return proto.CompactTextString(m) }
+func (*DisableTOTPRequest) ProtoMessage()    {}
+func (*DisableTOTPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
+}
+
+func (m *DisableTOTPRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableTOTPRequest.Unmarshal(m, b)
+}
+func (m *DisableTOTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableTOTPRequest.Marshal(b, m, deterministic)
+}
+func (m *DisableTOTPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTOTPRequest.Merge(m, src)
+}
+func (m *DisableTOTPRequest) XXX_Size() int {
+	return xxx_messageInfo_DisableTOTPRequest.Size(m)
+}
+func (m *DisableTOTPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTOTPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableTOTPRequest proto.InternalMessageInfo
+
+func (m *DisableTOTPRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type DisableTOTPResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DisableTOTPResponse) Reset()         { *m = DisableTOTPResponse{} }
+func (m *DisableTOTPResponse) String() string { return proto.CompactTextString(m) }
+func (*DisableTOTPResponse) ProtoMessage()    {}
+func (*DisableTOTPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
+}
+
+func (m *DisableTOTPResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableTOTPResponse.Unmarshal(m, b)
+}
+func (m *DisableTOTPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableTOTPResponse.Marshal(b, m, deterministic)
+}
+func (m *DisableTOTPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTOTPResponse.Merge(m, src)
+}
+func (m *DisableTOTPResponse) XXX_Size() int {
+	return xxx_messageInfo_DisableTOTPResponse.Size(m)
+}
+func (m *DisableTOTPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTOTPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableTOTPResponse proto.InternalMessageInfo
+
+func (m *DisableTOTPResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type RequestPasswordResetRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestPasswordResetRequest) Reset()         { *m = RequestPasswordResetRequest{} }
+func (m *RequestPasswordResetRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetRequest) ProtoMessage()    {}
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
+}
+
+func (m *RequestPasswordResetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetRequest.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestPasswordResetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetRequest.Merge(m, src)
+}
+func (m *RequestPasswordResetRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetRequest.Size(m)
+}
+func (m *RequestPasswordResetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPasswordResetRequest proto.InternalMessageInfo
+
+func (m *RequestPasswordResetRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RequestPasswordResetResponse) Reset()         { *m = RequestPasswordResetResponse{} }
+func (m *RequestPasswordResetResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetResponse) ProtoMessage()    {}
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
+}
+
+func (m *RequestPasswordResetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetResponse.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetResponse.Marshal(b, m, deterministic)
+}
+func (m *RequestPasswordResetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetResponse.Merge(m, src)
+}
+func (m *RequestPasswordResetResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetResponse.Size(m)
+}
+func (m *RequestPasswordResetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPasswordResetResponse proto.InternalMessageInfo
+
+func (m *RequestPasswordResetResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type ResetPasswordRequest struct {
+	// token is the reset token sent by mail.
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPasswordRequest) Reset()         { *m = ResetPasswordRequest{} }
+func (m *ResetPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordRequest) ProtoMessage()    {}
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
+}
+
+func (m *ResetPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordRequest.Unmarshal(m, b)
+}
+func (m *ResetPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordRequest.Merge(m, src)
+}
+func (m *ResetPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordRequest.Size(m)
+}
+func (m *ResetPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordRequest proto.InternalMessageInfo
+
+func (m *ResetPasswordRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ResetPasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ResetPasswordResponse) Reset()         { *m = ResetPasswordResponse{} }
+func (m *ResetPasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordResponse) ProtoMessage()    {}
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
+}
+
+func (m *ResetPasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordResponse.Unmarshal(m, b)
+}
+func (m *ResetPasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordResponse.Marshal(b, m, deterministic)
+}
+func (m *ResetPasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordResponse.Merge(m, src)
+}
+func (m *ResetPasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordResponse.Size(m)
+}
+func (m *ResetPasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordResponse proto.InternalMessageInfo
+
+func (m *ResetPasswordResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type VerifyEmailRequest struct {
+	// token is the verification token sent by mail.
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyEmailRequest) Reset()         { *m = VerifyEmailRequest{} }
+func (m *VerifyEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyEmailRequest) ProtoMessage()    {}
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
+}
+
+func (m *VerifyEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyEmailRequest.Unmarshal(m, b)
+}
+func (m *VerifyEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyEmailRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyEmailRequest.Merge(m, src)
+}
+func (m *VerifyEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyEmailRequest.Size(m)
+}
+func (m *VerifyEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyEmailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyEmailRequest proto.InternalMessageInfo
+
+func (m *VerifyEmailRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *VerifyEmailResponse) Reset()         { *m = VerifyEmailResponse{} }
+func (m *VerifyEmailResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyEmailResponse) ProtoMessage()    {}
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
+}
+
+func (m *VerifyEmailResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyEmailResponse.Unmarshal(m, b)
+}
+func (m *VerifyEmailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyEmailResponse.Marshal(b, m, deterministic)
+}
+func (m *VerifyEmailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyEmailResponse.Merge(m, src)
+}
+func (m *VerifyEmailResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyEmailResponse.Size(m)
+}
+func (m *VerifyEmailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyEmailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyEmailResponse proto.InternalMessageInfo
+
+func (m *VerifyEmailResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type ResendVerificationResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ResendVerificationResponse) Reset()         { *m = ResendVerificationResponse{} }
+func (m *ResendVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*ResendVerificationResponse) ProtoMessage()    {}
+func (*ResendVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
+}
+
+func (m *ResendVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResendVerificationResponse.Unmarshal(m, b)
+}
+func (m *ResendVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResendVerificationResponse.Marshal(b, m, deterministic)
+}
+func (m *ResendVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResendVerificationResponse.Merge(m, src)
+}
+func (m *ResendVerificationResponse) XXX_Size() int {
+	return xxx_messageInfo_ResendVerificationResponse.Size(m)
+}
+func (m *ResendVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResendVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResendVerificationResponse proto.InternalMessageInfo
+
+func (m *ResendVerificationResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type UnlockAccountRequest struct {
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// address is a client IP address whose failed logins are cleared too.
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockAccountRequest) Reset()         { *m = UnlockAccountRequest{} }
+func (m *UnlockAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockAccountRequest) ProtoMessage()    {}
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
+}
+
+func (m *UnlockAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockAccountRequest.Unmarshal(m, b)
+}
+func (m *UnlockAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *UnlockAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockAccountRequest.Merge(m, src)
+}
+func (m *UnlockAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockAccountRequest.Size(m)
+}
+func (m *UnlockAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockAccountRequest proto.InternalMessageInfo
+
+func (m *UnlockAccountRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *UnlockAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type UnlockAccountResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UnlockAccountResponse) Reset()         { *m = UnlockAccountResponse{} }
+func (m *UnlockAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockAccountResponse) ProtoMessage()    {}
+func (*UnlockAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
+}
+
+func (m *UnlockAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockAccountResponse.Unmarshal(m, b)
+}
+func (m *UnlockAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *UnlockAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockAccountResponse.Merge(m, src)
+}
+func (m *UnlockAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_UnlockAccountResponse.Size(m)
+}
+func (m *UnlockAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockAccountResponse proto.InternalMessageInfo
+
+func (m *UnlockAccountResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type DeleteAccountRequest struct {
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	// otp is required if two-factor authentication is enabled.
+	Otp string `protobuf:"bytes,2,opt,name=otp,proto3" json:"otp,omitempty"`
+	// idToken re-authenticates accounts without a password. It must be
+	// issued within the last 5 minutes to an identity linked to the account.
+	IdToken              string   `protobuf:"bytes,3,opt,name=idToken,proto3" json:"idToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAccountRequest) Reset()         { *m = DeleteAccountRequest{} }
+func (m *DeleteAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAccountRequest) ProtoMessage()    {}
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
+}
+
+func (m *DeleteAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAccountRequest.Unmarshal(m, b)
+}
+func (m *DeleteAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAccountRequest.Merge(m, src)
+}
+func (m *DeleteAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAccountRequest.Size(m)
+}
+func (m *DeleteAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAccountRequest proto.InternalMessageInfo
+
+func (m *DeleteAccountRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *DeleteAccountRequest) GetOtp() string {
+	if m != nil {
+		return m.Otp
+	}
+	return ""
+}
+
+func (m *DeleteAccountRequest) GetIdToken() string {
+	if m != nil {
+		return m.IdToken
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DeleteAccountResponse) Reset()         { *m = DeleteAccountResponse{} }
+func (m *DeleteAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAccountResponse) ProtoMessage()    {}
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86}
+}
+
+func (m *DeleteAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAccountResponse.Unmarshal(m, b)
+}
+func (m *DeleteAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAccountResponse.Merge(m, src)
+}
+func (m *DeleteAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAccountResponse.Size(m)
+}
+func (m *DeleteAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAccountResponse proto.InternalMessageInfo
+
+func (m *DeleteAccountResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type ExportAccountDataResponse struct {
+	Status ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	// data is a JSON document with the account and its suspension, API
+	// keys, client certificates, OpenID Connect identities, organizations,
+	// zones with their records, pending zones, shares and transfers.
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportAccountDataResponse) Reset()         { *m = ExportAccountDataResponse{} }
+func (m *ExportAccountDataResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportAccountDataResponse) ProtoMessage()    {}
+func (*ExportAccountDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{87}
+}
+
+func (m *ExportAccountDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportAccountDataResponse.Unmarshal(m, b)
+}
+func (m *ExportAccountDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportAccountDataResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportAccountDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportAccountDataResponse.Merge(m, src)
+}
+func (m *ExportAccountDataResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportAccountDataResponse.Size(m)
+}
+func (m *ExportAccountDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportAccountDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportAccountDataResponse proto.InternalMessageInfo
+
+func (m *ExportAccountDataResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *ExportAccountDataResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ExchangeOIDCTokenRequest struct {
+	// idToken is an ID token of an issuer listed in OIDC_CONFIG.
+	IdToken string `protobuf:"bytes,1,opt,name=idToken,proto3" json:"idToken,omitempty"`
+	// otp is required if the account has two-factor authentication
+	// enabled. The second factor of the issuer does not replace it.
+	Otp                  string   `protobuf:"bytes,2,opt,name=otp,proto3" json:"otp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExchangeOIDCTokenRequest) Reset()         { *m = ExchangeOIDCTokenRequest{} }
+func (m *ExchangeOIDCTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*ExchangeOIDCTokenRequest) ProtoMessage()    {}
+func (*ExchangeOIDCTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88}
+}
+
+func (m *ExchangeOIDCTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeOIDCTokenRequest.Unmarshal(m, b)
+}
+func (m *ExchangeOIDCTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeOIDCTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *ExchangeOIDCTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeOIDCTokenRequest.Merge(m, src)
+}
+func (m *ExchangeOIDCTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_ExchangeOIDCTokenRequest.Size(m)
+}
+func (m *ExchangeOIDCTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeOIDCTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeOIDCTokenRequest proto.InternalMessageInfo
+
+func (m *ExchangeOIDCTokenRequest) GetIdToken() string {
+	if m != nil {
+		return m.IdToken
+	}
+	return ""
+}
+
+func (m *ExchangeOIDCTokenRequest) GetOtp() string {
+	if m != nil {
+		return m.Otp
+	}
+	return ""
+}
+
+type ExchangeOIDCTokenResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Token                string         `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken         string         `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ExchangeOIDCTokenResponse) Reset()         { *m = ExchangeOIDCTokenResponse{} }
+func (m *ExchangeOIDCTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*ExchangeOIDCTokenResponse) ProtoMessage()    {}
+func (*ExchangeOIDCTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{89}
+}
+
+func (m *ExchangeOIDCTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeOIDCTokenResponse.Unmarshal(m, b)
+}
+func (m *ExchangeOIDCTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeOIDCTokenResponse.Marshal(b, m, deterministic)
+}
+func (m *ExchangeOIDCTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeOIDCTokenResponse.Merge(m, src)
+}
+func (m *ExchangeOIDCTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_ExchangeOIDCTokenResponse.Size(m)
+}
+func (m *ExchangeOIDCTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeOIDCTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeOIDCTokenResponse proto.InternalMessageInfo
+
+func (m *ExchangeOIDCTokenResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *ExchangeOIDCTokenResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ExchangeOIDCTokenResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+// ClientCertificate maps the subject of a client certificate to an
+// account. Times are unix seconds, 0 means never.
+type ClientCertificate struct {
+	Subject              string   `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	LastUsedAt           int64    `protobuf:"varint,4,opt,name=lastUsedAt,proto3" json:"lastUsedAt,omitempty"`
+	Issuer               string   `protobuf:"bytes,5,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClientCertificate) Reset()         { *m = ClientCertificate{} }
+func (m *ClientCertificate) String() string { return proto.CompactTextString(m) }
+func (*ClientCertificate) ProtoMessage()    {}
+func (*ClientCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{90}
+}
+
+func (m *ClientCertificate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientCertificate.Unmarshal(m, b)
+}
+func (m *ClientCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientCertificate.Marshal(b, m, deterministic)
+}
+func (m *ClientCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientCertificate.Merge(m, src)
+}
+func (m *ClientCertificate) XXX_Size() int {
+	return xxx_messageInfo_ClientCertificate.Size(m)
+}
+func (m *ClientCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientCertificate proto.InternalMessageInfo
+
+func (m *ClientCertificate) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ClientCertificate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ClientCertificate) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ClientCertificate) GetLastUsedAt() int64 {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return 0
+}
+
+func (m *ClientCertificate) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+type RegisterClientCertificateRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterClientCertificateRequest) Reset()         { *m = RegisterClientCertificateRequest{} }
+func (m *RegisterClientCertificateRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterClientCertificateRequest) ProtoMessage()    {}
+func (*RegisterClientCertificateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{91}
+}
+
+func (m *RegisterClientCertificateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterClientCertificateRequest.Unmarshal(m, b)
+}
+func (m *RegisterClientCertificateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterClientCertificateRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterClientCertificateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterClientCertificateRequest.Merge(m, src)
+}
+func (m *RegisterClientCertificateRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterClientCertificateRequest.Size(m)
+}
+func (m *RegisterClientCertificateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterClientCertificateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterClientCertificateRequest proto.InternalMessageInfo
+
+func (m *RegisterClientCertificateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RegisterClientCertificateResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Subject              string         `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Issuer               string         `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RegisterClientCertificateResponse) Reset()         { *m = RegisterClientCertificateResponse{} }
+func (m *RegisterClientCertificateResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterClientCertificateResponse) ProtoMessage()    {}
+func (*RegisterClientCertificateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{92}
+}
+
+func (m *RegisterClientCertificateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterClientCertificateResponse.Unmarshal(m, b)
+}
+func (m *RegisterClientCertificateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterClientCertificateResponse.Marshal(b, m, deterministic)
+}
+func (m *RegisterClientCertificateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterClientCertificateResponse.Merge(m, src)
+}
+func (m *RegisterClientCertificateResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterClientCertificateResponse.Size(m)
+}
+func (m *RegisterClientCertificateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterClientCertificateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterClientCertificateResponse proto.InternalMessageInfo
+
+func (m *RegisterClientCertificateResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *RegisterClientCertificateResponse) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *RegisterClientCertificateResponse) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+type ListClientCertificatesResponse struct {
+	Status               ResponseStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Certificates         []*ClientCertificate `protobuf:"bytes,2,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListClientCertificatesResponse) Reset()         { *m = ListClientCertificatesResponse{} }
+func (m *ListClientCertificatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListClientCertificatesResponse) ProtoMessage()    {}
+func (*ListClientCertificatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{93}
+}
+
+func (m *ListClientCertificatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListClientCertificatesResponse.Unmarshal(m, b)
+}
+func (m *ListClientCertificatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListClientCertificatesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListClientCertificatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClientCertificatesResponse.Merge(m, src)
+}
+func (m *ListClientCertificatesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListClientCertificatesResponse.Size(m)
+}
+func (m *ListClientCertificatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClientCertificatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListClientCertificatesResponse proto.InternalMessageInfo
+
+func (m *ListClientCertificatesResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *ListClientCertificatesResponse) GetCertificates() []*ClientCertificate {
+	if m != nil {
+		return m.Certificates
+	}
+	return nil
+}
+
+type RemoveClientCertificateRequest struct {
+	Subject              string   `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Issuer               string   `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveClientCertificateRequest) Reset()         { *m = RemoveClientCertificateRequest{} }
+func (m *RemoveClientCertificateRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveClientCertificateRequest) ProtoMessage()    {}
+func (*RemoveClientCertificateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{94}
+}
+
+func (m *RemoveClientCertificateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveClientCertificateRequest.Unmarshal(m, b)
+}
+func (m *RemoveClientCertificateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveClientCertificateRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveClientCertificateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveClientCertificateRequest.Merge(m, src)
+}
+func (m *RemoveClientCertificateRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveClientCertificateRequest.Size(m)
+}
+func (m *RemoveClientCertificateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveClientCertificateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveClientCertificateRequest proto.InternalMessageInfo
+
+func (m *RemoveClientCertificateRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *RemoveClientCertificateRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+type RemoveClientCertificateResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RemoveClientCertificateResponse) Reset()         { *m = RemoveClientCertificateResponse{} }
+func (m *RemoveClientCertificateResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveClientCertificateResponse) ProtoMessage()    {}
+func (*RemoveClientCertificateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{95}
+}
+
+func (m *RemoveClientCertificateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveClientCertificateResponse.Unmarshal(m, b)
+}
+func (m *RemoveClientCertificateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveClientCertificateResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveClientCertificateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveClientCertificateResponse.Merge(m, src)
+}
+func (m *RemoveClientCertificateResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveClientCertificateResponse.Size(m)
+}
+func (m *RemoveClientCertificateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveClientCertificateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveClientCertificateResponse proto.InternalMessageInfo
+
+func (m *RemoveClientCertificateResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+// AuditEvent is a recorded call of a method which changes something.
+type AuditEvent struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// email is the caller, empty for calls without authentication.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// apiKey is the id of the API key used, or 0.
+	ApiKey int64  `protobuf:"varint,3,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Zone   string `protobuf:"bytes,5,opt,name=zone,proto3" json:"zone,omitempty"`
+	// payload is the request as JSON, with secrets redacted.
+	Payload string `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	// code is the gRPC status code of the result, such as OK.
+	Code                 string   `protobuf:"bytes,7,opt,name=code,proto3" json:"code,omitempty"`
+	Peer                 string   `protobuf:"bytes,8,opt,name=peer,proto3" json:"peer,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{96}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetApiKey() int64 {
+	if m != nil {
+		return m.ApiKey
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type ListAuditEventsRequest struct {
+	Zone  string `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// since and until limit the time range in unix seconds. 0 is unbounded.
+	Since                int64    `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	Until                int64    `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`
+	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuditEventsRequest) Reset()         { *m = ListAuditEventsRequest{} }
+func (m *ListAuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsRequest) ProtoMessage()    {}
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{97}
+}
+
+func (m *ListAuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsRequest.Unmarshal(m, b)
+}
+func (m *ListAuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsRequest.Merge(m, src)
+}
+func (m *ListAuditEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsRequest.Size(m)
+}
+func (m *ListAuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsRequest proto.InternalMessageInfo
+
+func (m *ListAuditEventsRequest) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *ListAuditEventsRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *ListAuditEventsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListAuditEventsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	Status ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	// events are ordered from the newest.
+	Events               []*AuditEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListAuditEventsResponse) Reset()         { *m = ListAuditEventsResponse{} }
+func (m *ListAuditEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsResponse) ProtoMessage()    {}
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{98}
+}
+
+func (m *ListAuditEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsResponse.Unmarshal(m, b)
+}
+func (m *ListAuditEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsResponse.Merge(m, src)
+}
+func (m *ListAuditEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsResponse.Size(m)
+}
+func (m *ListAuditEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsResponse proto.InternalMessageInfo
+
+func (m *ListAuditEventsResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type AccountSummary struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	EmailVerified        bool     `protobuf:"varint,3,opt,name=emailVerified,proto3" json:"emailVerified,omitempty"`
+	Admin                bool     `protobuf:"varint,4,opt,name=admin,proto3" json:"admin,omitempty"`
+	TotpEnabled          bool     `protobuf:"varint,5,opt,name=totpEnabled,proto3" json:"totpEnabled,omitempty"`
+	SuspendedAt          int64    `protobuf:"varint,6,opt,name=suspendedAt,proto3" json:"suspendedAt,omitempty"`
+	SuspendedReason      string   `protobuf:"bytes,7,opt,name=suspendedReason,proto3" json:"suspendedReason,omitempty"`
+	Zones                int64    `protobuf:"varint,8,opt,name=zones,proto3" json:"zones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountSummary) Reset()         { *m = AccountSummary{} }
+func (m *AccountSummary) String() string { return proto.CompactTextString(m) }
+func (*AccountSummary) ProtoMessage()    {}
+func (*AccountSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{99}
+}
+
+func (m *AccountSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountSummary.Unmarshal(m, b)
+}
+func (m *AccountSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountSummary.Marshal(b, m, deterministic)
+}
+func (m *AccountSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountSummary.Merge(m, src)
+}
+func (m *AccountSummary) XXX_Size() int {
+	return xxx_messageInfo_AccountSummary.Size(m)
+}
+func (m *AccountSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountSummary proto.InternalMessageInfo
+
+func (m *AccountSummary) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AccountSummary) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *AccountSummary) GetEmailVerified() bool {
+	if m != nil {
+		return m.EmailVerified
+	}
+	return false
+}
+
+func (m *AccountSummary) GetAdmin() bool {
+	if m != nil {
+		return m.Admin
+	}
+	return false
+}
+
+func (m *AccountSummary) GetTotpEnabled() bool {
+	if m != nil {
+		return m.TotpEnabled
+	}
+	return false
+}
+
+func (m *AccountSummary) GetSuspendedAt() int64 {
+	if m != nil {
+		return m.SuspendedAt
+	}
+	return 0
+}
+
+func (m *AccountSummary) GetSuspendedReason() string {
+	if m != nil {
+		return m.SuspendedReason
+	}
+	return ""
+}
+
+func (m *AccountSummary) GetZones() int64 {
+	if m != nil {
+		return m.Zones
+	}
+	return 0
+}
+
+type ListAccountsRequest struct {
+	// query matches a part of the email. Empty matches every account.
+	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAccountsRequest) Reset()         { *m = ListAccountsRequest{} }
+func (m *ListAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsRequest) ProtoMessage()    {}
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{100}
+}
+
+func (m *ListAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsRequest.Unmarshal(m, b)
+}
+func (m *ListAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsRequest.Merge(m, src)
+}
+func (m *ListAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsRequest.Size(m)
+}
+func (m *ListAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsRequest proto.InternalMessageInfo
+
+func (m *ListAccountsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ListAccountsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListAccountsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListAccountsResponse struct {
+	Status               ResponseStatus    `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Accounts             []*AccountSummary `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListAccountsResponse) Reset()         { *m = ListAccountsResponse{} }
+func (m *ListAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsResponse) ProtoMessage()    {}
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{101}
+}
+
+func (m *ListAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsResponse.Unmarshal(m, b)
+}
+func (m *ListAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsResponse.Merge(m, src)
+}
+func (m *ListAccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsResponse.Size(m)
+}
+func (m *ListAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+
+func (m *ListAccountsResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *ListAccountsResponse) GetAccounts() []*AccountSummary {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type ZoneSummary struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner                string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Organization         string   `protobuf:"bytes,4,opt,name=organization,proto3" json:"organization,omitempty"`
+	Records              int64    `protobuf:"varint,5,opt,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZoneSummary) Reset()         { *m = ZoneSummary{} }
+func (m *ZoneSummary) String() string { return proto.CompactTextString(m) }
+func (*ZoneSummary) ProtoMessage()    {}
+func (*ZoneSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{102}
+}
+
+func (m *ZoneSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZoneSummary.Unmarshal(m, b)
+}
+func (m *ZoneSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZoneSummary.Marshal(b, m, deterministic)
+}
+func (m *ZoneSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneSummary.Merge(m, src)
+}
+func (m *ZoneSummary) XXX_Size() int {
+	return xxx_messageInfo_ZoneSummary.Size(m)
+}
+func (m *ZoneSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneSummary proto.InternalMessageInfo
+
+func (m *ZoneSummary) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ZoneSummary) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ZoneSummary) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ZoneSummary) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *ZoneSummary) GetRecords() int64 {
+	if m != nil {
+		return m.Records
+	}
+	return 0
+}
+
+type ListZonesRequest struct {
+	// query matches a part of the zone name.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// email limits the zones to the ones owned by the account.
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListZonesRequest) Reset()         { *m = ListZonesRequest{} }
+func (m *ListZonesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListZonesRequest) ProtoMessage()    {}
+func (*ListZonesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{103}
+}
+
+func (m *ListZonesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListZonesRequest.Unmarshal(m, b)
+}
+func (m *ListZonesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListZonesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListZonesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListZonesRequest.Merge(m, src)
+}
+func (m *ListZonesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListZonesRequest.Size(m)
+}
+func (m *ListZonesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListZonesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListZonesRequest proto.InternalMessageInfo
+
+func (m *ListZonesRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ListZonesRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *ListZonesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListZonesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListZonesResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	Zones                []*ZoneSummary `protobuf:"bytes,2,rep,name=zones,proto3" json:"zones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListZonesResponse) Reset()         { *m = ListZonesResponse{} }
+func (m *ListZonesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListZonesResponse) ProtoMessage()    {}
+func (*ListZonesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{104}
+}
+
+func (m *ListZonesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListZonesResponse.Unmarshal(m, b)
+}
+func (m *ListZonesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListZonesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListZonesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListZonesResponse.Merge(m, src)
+}
+func (m *ListZonesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListZonesResponse.Size(m)
+}
+func (m *ListZonesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListZonesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListZonesResponse proto.InternalMessageInfo
+
+func (m *ListZonesResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *ListZonesResponse) GetZones() []*ZoneSummary {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+type ImpersonateRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImpersonateRequest) Reset()         { *m = ImpersonateRequest{} }
+func (m *ImpersonateRequest) String() string { return proto.CompactTextString(m) }
+func (*ImpersonateRequest) ProtoMessage()    {}
+func (*ImpersonateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{105}
+}
+
+func (m *ImpersonateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImpersonateRequest.Unmarshal(m, b)
+}
+func (m *ImpersonateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImpersonateRequest.Marshal(b, m, deterministic)
+}
+func (m *ImpersonateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImpersonateRequest.Merge(m, src)
+}
+func (m *ImpersonateRequest) XXX_Size() int {
+	return xxx_messageInfo_ImpersonateRequest.Size(m)
+}
+func (m *ImpersonateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImpersonateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImpersonateRequest proto.InternalMessageInfo
+
+func (m *ImpersonateRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type ImpersonateResponse struct {
+	Status ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	// token is read-only and cannot be refreshed.
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImpersonateResponse) Reset()         { *m = ImpersonateResponse{} }
+func (m *ImpersonateResponse) String() string { return proto.CompactTextString(m) }
+func (*ImpersonateResponse) ProtoMessage()    {}
+func (*ImpersonateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{106}
+}
+
+func (m *ImpersonateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImpersonateResponse.Unmarshal(m, b)
+}
+func (m *ImpersonateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImpersonateResponse.Marshal(b, m, deterministic)
+}
+func (m *ImpersonateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImpersonateResponse.Merge(m, src)
+}
+func (m *ImpersonateResponse) XXX_Size() int {
+	return xxx_messageInfo_ImpersonateResponse.Size(m)
+}
+func (m *ImpersonateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImpersonateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImpersonateResponse proto.InternalMessageInfo
+
+func (m *ImpersonateResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func (m *ImpersonateResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type SuspendAccountRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuspendAccountRequest) Reset()         { *m = SuspendAccountRequest{} }
+func (m *SuspendAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*SuspendAccountRequest) ProtoMessage()    {}
+func (*SuspendAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{107}
+}
+
+func (m *SuspendAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuspendAccountRequest.Unmarshal(m, b)
+}
+func (m *SuspendAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuspendAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *SuspendAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendAccountRequest.Merge(m, src)
+}
+func (m *SuspendAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_SuspendAccountRequest.Size(m)
+}
+func (m *SuspendAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendAccountRequest proto.InternalMessageInfo
+
+func (m *SuspendAccountRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *SuspendAccountRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type SuspendAccountResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SuspendAccountResponse) Reset()         { *m = SuspendAccountResponse{} }
+func (m *SuspendAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*SuspendAccountResponse) ProtoMessage()    {}
+func (*SuspendAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{108}
+}
+
+func (m *SuspendAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuspendAccountResponse.Unmarshal(m, b)
+}
+func (m *SuspendAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuspendAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *SuspendAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendAccountResponse.Merge(m, src)
+}
+func (m *SuspendAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_SuspendAccountResponse.Size(m)
+}
+func (m *SuspendAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendAccountResponse proto.InternalMessageInfo
+
+func (m *SuspendAccountResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type UnsuspendAccountRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsuspendAccountRequest) Reset()         { *m = UnsuspendAccountRequest{} }
+func (m *UnsuspendAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsuspendAccountRequest) ProtoMessage()    {}
+func (*UnsuspendAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{109}
+}
+
+func (m *UnsuspendAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsuspendAccountRequest.Unmarshal(m, b)
+}
+func (m *UnsuspendAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsuspendAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *UnsuspendAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsuspendAccountRequest.Merge(m, src)
+}
+func (m *UnsuspendAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_UnsuspendAccountRequest.Size(m)
+}
+func (m *UnsuspendAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsuspendAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsuspendAccountRequest proto.InternalMessageInfo
+
+func (m *UnsuspendAccountRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type UnsuspendAccountResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UnsuspendAccountResponse) Reset()         { *m = UnsuspendAccountResponse{} }
+func (m *UnsuspendAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsuspendAccountResponse) ProtoMessage()    {}
+func (*UnsuspendAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{110}
+}
+
+func (m *UnsuspendAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsuspendAccountResponse.Unmarshal(m, b)
+}
+func (m *UnsuspendAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsuspendAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *UnsuspendAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsuspendAccountResponse.Merge(m, src)
+}
+func (m *UnsuspendAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_UnsuspendAccountResponse.Size(m)
+}
+func (m *UnsuspendAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsuspendAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsuspendAccountResponse proto.InternalMessageInfo
+
+func (m *UnsuspendAccountResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type ForceRemoveZoneRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForceRemoveZoneRequest) Reset()         { *m = ForceRemoveZoneRequest{} }
+func (m *ForceRemoveZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*ForceRemoveZoneRequest) ProtoMessage()    {}
+func (*ForceRemoveZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{111}
+}
+
+func (m *ForceRemoveZoneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForceRemoveZoneRequest.Unmarshal(m, b)
+}
+func (m *ForceRemoveZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForceRemoveZoneRequest.Marshal(b, m, deterministic)
+}
+func (m *ForceRemoveZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceRemoveZoneRequest.Merge(m, src)
+}
+func (m *ForceRemoveZoneRequest) XXX_Size() int {
+	return xxx_messageInfo_ForceRemoveZoneRequest.Size(m)
+}
+func (m *ForceRemoveZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceRemoveZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceRemoveZoneRequest proto.InternalMessageInfo
+
+func (m *ForceRemoveZoneRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type ForceRemoveZoneResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ForceRemoveZoneResponse) Reset()         { *m = ForceRemoveZoneResponse{} }
+func (m *ForceRemoveZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*ForceRemoveZoneResponse) ProtoMessage()    {}
+func (*ForceRemoveZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{112}
+}
+
+func (m *ForceRemoveZoneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForceRemoveZoneResponse.Unmarshal(m, b)
+}
+func (m *ForceRemoveZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForceRemoveZoneResponse.Marshal(b, m, deterministic)
+}
+func (m *ForceRemoveZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceRemoveZoneResponse.Merge(m, src)
+}
+func (m *ForceRemoveZoneResponse) XXX_Size() int {
+	return xxx_messageInfo_ForceRemoveZoneResponse.Size(m)
+}
+func (m *ForceRemoveZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceRemoveZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceRemoveZoneResponse proto.InternalMessageInfo
+
+func (m *ForceRemoveZoneResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+type ReassignZoneRequest struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// email is the new owner.
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReassignZoneRequest) Reset()         { *m = ReassignZoneRequest{} }
+func (m *ReassignZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*ReassignZoneRequest) ProtoMessage()    {}
+func (*ReassignZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{113}
+}
+
+func (m *ReassignZoneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReassignZoneRequest.Unmarshal(m, b)
+}
+func (m *ReassignZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReassignZoneRequest.Marshal(b, m, deterministic)
+}
+func (m *ReassignZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReassignZoneRequest.Merge(m, src)
+}
+func (m *ReassignZoneRequest) XXX_Size() int {
+	return xxx_messageInfo_ReassignZoneRequest.Size(m)
+}
+func (m *ReassignZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReassignZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReassignZoneRequest proto.InternalMessageInfo
+
+func (m *ReassignZoneRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ReassignZoneRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type ReassignZoneResponse struct {
+	Status               ResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ResponseStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReassignZoneResponse) Reset()         { *m = ReassignZoneResponse{} }
+func (m *ReassignZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*ReassignZoneResponse) ProtoMessage()    {}
+func (*ReassignZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{114}
+}
+
+func (m *ReassignZoneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReassignZoneResponse.Unmarshal(m, b)
+}
+func (m *ReassignZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReassignZoneResponse.Marshal(b, m, deterministic)
+}
+func (m *ReassignZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReassignZoneResponse.Merge(m, src)
+}
+func (m *ReassignZoneResponse) XXX_Size() int {
+	return xxx_messageInfo_ReassignZoneResponse.Size(m)
+}
+func (m *ReassignZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReassignZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReassignZoneResponse proto.InternalMessageInfo
+
+func (m *ReassignZoneResponse) GetStatus() ResponseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResponseStatus_Ok
+}
+
+func init() {
+	proto.RegisterEnum("api.APIKeyScope", APIKeyScope_name, APIKeyScope_value)
+	proto.RegisterEnum("api.Role", Role_name, Role_value)
+	proto.RegisterEnum("api.ResponseStatus", ResponseStatus_name, ResponseStatus_value)
+	proto.RegisterEnum("api.RRType", RRType_name, RRType_value)
+	proto.RegisterEnum("api.CreateAccountResponse_Status", CreateAccountResponse_Status_name, CreateAccountResponse_Status_value)
+	proto.RegisterEnum("api.ExportZoneRequest_Format", ExportZoneRequest_Format_name, ExportZoneRequest_Format_value)
+	proto.RegisterEnum("api.RRSet_ChangeType", RRSet_ChangeType_name, RRSet_ChangeType_value)
+	proto.RegisterEnum("api.ZoneTransfer_State", ZoneTransfer_State_name, ZoneTransfer_State_value)
+	proto.RegisterType((*Ping)(nil), "api.Ping")
+	proto.RegisterType((*Pong)(nil), "api.Pong")
+	proto.RegisterType((*CreateAccountRequest)(nil), "api.CreateAccountRequest")
+	proto.RegisterType((*CreateAccountResponse)(nil), "api.CreateAccountResponse")
+	proto.RegisterType((*GetTokenRequest)(nil), "api.getTokenRequest")
+	proto.RegisterType((*GetTokenResponse)(nil), "api.getTokenResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "api.changePasswordRequest")
+	proto.RegisterType((*ChangePasswordResponse)(nil), "api.changePasswordResponse")
+	proto.RegisterType((*InitZoneRequest)(nil), "api.InitZoneRequest")
+	proto.RegisterType((*InitZoneResponse)(nil), "api.InitZoneResponse")
+	proto.RegisterType((*PendingDomain)(nil), "api.PendingDomain")
+	proto.RegisterType((*VerifyZoneRequest)(nil), "api.VerifyZoneRequest")
+	proto.RegisterType((*VerifyZoneResponse)(nil), "api.VerifyZoneResponse")
+	proto.RegisterType((*RemoveZoneRequest)(nil), "api.RemoveZoneRequest")
+	proto.RegisterType((*RemoveZoneResponse)(nil), "api.RemoveZoneResponse")
+	proto.RegisterType((*AddRecordRequest)(nil), "api.AddRecordRequest")
+	proto.RegisterType((*AddRecordResponse)(nil), "api.AddRecordResponse")
+	proto.RegisterType((*RemoveRecordRequest)(nil), "api.RemoveRecordRequest")
+	proto.RegisterType((*RemoveRecordResponse)(nil), "api.RemoveRecordResponse")
+	proto.RegisterType((*UpdateRecordRequest)(nil), "api.UpdateRecordRequest")
+	proto.RegisterType((*UpdateRecordRequest_Target)(nil), "api.UpdateRecordRequest.Target")
+	proto.RegisterType((*UpdateRecordRequest_Source)(nil), "api.UpdateRecordRequest.Source")
+	proto.RegisterType((*UpdateRecordResponse)(nil), "api.UpdateRecordResponse")
+	proto.RegisterType((*GetDomainsResponse)(nil), "api.GetDomainsResponse")
+	proto.RegisterType((*Domain)(nil), "api.Domain")
 	proto.RegisterType((*GetRecordsRequest)(nil), "api.GetRecordsRequest")
 	proto.RegisterType((*GetRecordsResponse)(nil), "api.GetRecordsResponse")
 	proto.RegisterType((*Record)(nil), "api.Record")
+	proto.RegisterType((*ImportZoneRequest)(nil), "api.ImportZoneRequest")
+	proto.RegisterType((*ImportZoneResponse)(nil), "api.ImportZoneResponse")
+	proto.RegisterType((*ExportZoneRequest)(nil), "api.ExportZoneRequest")
+	proto.RegisterType((*ExportZoneResponse)(nil), "api.ExportZoneResponse")
+	proto.RegisterType((*ApplyChangesRequest)(nil), "api.ApplyChangesRequest")
+	proto.RegisterType((*RRSet)(nil), "api.RRSet")
+	proto.RegisterType((*ApplyChangesResponse)(nil), "api.ApplyChangesResponse")
+	proto.RegisterType((*RefreshTokenRequest)(nil), "api.RefreshTokenRequest")
+	proto.RegisterType((*RefreshTokenResponse)(nil), "api.RefreshTokenResponse")
+	proto.RegisterType((*LogoutRequest)(nil), "api.LogoutRequest")
+	proto.RegisterType((*LogoutResponse)(nil), "api.LogoutResponse")
+	proto.RegisterType((*JWK)(nil), "api.JWK")
+	proto.RegisterType((*GetJWKSResponse)(nil), "api.GetJWKSResponse")
+	proto.RegisterType((*APIKey)(nil), "api.APIKey")
+	proto.RegisterType((*CreateAPIKeyRequest)(nil), "api.CreateAPIKeyRequest")
+	proto.RegisterType((*CreateAPIKeyResponse)(nil), "api.CreateAPIKeyResponse")
+	proto.RegisterType((*ListAPIKeysResponse)(nil), "api.ListAPIKeysResponse")
+	proto.RegisterType((*RevokeAPIKeyRequest)(nil), "api.RevokeAPIKeyRequest")
+	proto.RegisterType((*RevokeAPIKeyResponse)(nil), "api.RevokeAPIKeyResponse")
+	proto.RegisterType((*Organization)(nil), "api.Organization")
+	proto.RegisterType((*Member)(nil), "api.Member")
+	proto.RegisterType((*CreateOrganizationRequest)(nil), "api.CreateOrganizationRequest")
+	proto.RegisterType((*CreateOrganizationResponse)(nil), "api.CreateOrganizationResponse")
+	proto.RegisterType((*GetOrganizationsResponse)(nil), "api.GetOrganizationsResponse")
+	proto.RegisterType((*GetMembersRequest)(nil), "api.GetMembersRequest")
+	proto.RegisterType((*GetMembersResponse)(nil), "api.GetMembersResponse")
+	proto.RegisterType((*InviteMemberRequest)(nil), "api.InviteMemberRequest")
+	proto.RegisterType((*InviteMemberResponse)(nil), "api.InviteMemberResponse")
+	proto.RegisterType((*RemoveMemberRequest)(nil), "api.RemoveMemberRequest")
+	proto.RegisterType((*RemoveMemberResponse)(nil), "api.RemoveMemberResponse")
+	proto.RegisterType((*ZoneShare)(nil), "api.ZoneShare")
+	proto.RegisterType((*ShareZoneRequest)(nil), "api.ShareZoneRequest")
+	proto.RegisterType((*ShareZoneResponse)(nil), "api.ShareZoneResponse")
+	proto.RegisterType((*UnshareZoneRequest)(nil), "api.UnshareZoneRequest")
+	proto.RegisterType((*UnshareZoneResponse)(nil), "api.UnshareZoneResponse")
+	proto.RegisterType((*ListZoneSharesRequest)(nil), "api.ListZoneSharesRequest")
+	proto.RegisterType((*ListZoneSharesResponse)(nil), "api.ListZoneSharesResponse")
+	proto.RegisterType((*ZoneTransfer)(nil), "api.ZoneTransfer")
+	proto.RegisterType((*TransferZoneRequest)(nil), "api.TransferZoneRequest")
+	proto.RegisterType((*TransferZoneResponse)(nil), "api.TransferZoneResponse")
+	proto.RegisterType((*AcceptZoneTransferRequest)(nil), "api.AcceptZoneTransferRequest")
+	proto.RegisterType((*AcceptZoneTransferResponse)(nil), "api.AcceptZoneTransferResponse")
+	proto.RegisterType((*CancelZoneTransferRequest)(nil), "api.CancelZoneTransferRequest")
+	proto.RegisterType((*CancelZoneTransferResponse)(nil), "api.CancelZoneTransferResponse")
+	proto.RegisterType((*ListZoneTransfersResponse)(nil), "api.ListZoneTransfersResponse")
+	proto.RegisterType((*EnrollTOTPResponse)(nil), "api.EnrollTOTPResponse")
+	proto.RegisterType((*ConfirmTOTPRequest)(nil), "api.ConfirmTOTPRequest")
+	proto.RegisterType((*ConfirmTOTPResponse)(nil), "api.ConfirmTOTPResponse")
+	proto.RegisterType((*DisableTOTPRequest)(nil), "api.DisableTOTPRequest")
+	proto.RegisterType((*DisableTOTPResponse)(nil), "api.DisableTOTPResponse")
+	proto.RegisterType((*RequestPasswordResetRequest)(nil), "api.RequestPasswordResetRequest")
+	proto.RegisterType((*RequestPasswordResetResponse)(nil), "api.RequestPasswordResetResponse")
+	proto.RegisterType((*ResetPasswordRequest)(nil), "api.ResetPasswordRequest")
+	proto.RegisterType((*ResetPasswordResponse)(nil), "api.ResetPasswordResponse")
+	proto.RegisterType((*VerifyEmailRequest)(nil), "api.VerifyEmailRequest")
+	proto.RegisterType((*VerifyEmailResponse)(nil), "api.VerifyEmailResponse")
+	proto.RegisterType((*ResendVerificationResponse)(nil), "api.ResendVerificationResponse")
+	proto.RegisterType((*UnlockAccountRequest)(nil), "api.UnlockAccountRequest")
+	proto.RegisterType((*UnlockAccountResponse)(nil), "api.UnlockAccountResponse")
+	proto.RegisterType((*DeleteAccountRequest)(nil), "api.DeleteAccountRequest")
+	proto.RegisterType((*DeleteAccountResponse)(nil), "api.DeleteAccountResponse")
+	proto.RegisterType((*ExportAccountDataResponse)(nil), "api.ExportAccountDataResponse")
+	proto.RegisterType((*ExchangeOIDCTokenRequest)(nil), "api.ExchangeOIDCTokenRequest")
+	proto.RegisterType((*ExchangeOIDCTokenResponse)(nil), "api.ExchangeOIDCTokenResponse")
+	proto.RegisterType((*ClientCertificate)(nil), "api.ClientCertificate")
+	proto.RegisterType((*RegisterClientCertificateRequest)(nil), "api.RegisterClientCertificateRequest")
+	proto.RegisterType((*RegisterClientCertificateResponse)(nil), "api.RegisterClientCertificateResponse")
+	proto.RegisterType((*ListClientCertificatesResponse)(nil), "api.ListClientCertificatesResponse")
+	proto.RegisterType((*RemoveClientCertificateRequest)(nil), "api.RemoveClientCertificateRequest")
+	proto.RegisterType((*RemoveClientCertificateResponse)(nil), "api.RemoveClientCertificateResponse")
+	proto.RegisterType((*AuditEvent)(nil), "api.AuditEvent")
+	proto.RegisterType((*ListAuditEventsRequest)(nil), "api.ListAuditEventsRequest")
+	proto.RegisterType((*ListAuditEventsResponse)(nil), "api.ListAuditEventsResponse")
+	proto.RegisterType((*AccountSummary)(nil), "api.AccountSummary")
+	proto.RegisterType((*ListAccountsRequest)(nil), "api.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "api.ListAccountsResponse")
+	proto.RegisterType((*ZoneSummary)(nil), "api.ZoneSummary")
+	proto.RegisterType((*ListZonesRequest)(nil), "api.ListZonesRequest")
+	proto.RegisterType((*ListZonesResponse)(nil), "api.ListZonesResponse")
+	proto.RegisterType((*ImpersonateRequest)(nil), "api.ImpersonateRequest")
+	proto.RegisterType((*ImpersonateResponse)(nil), "api.ImpersonateResponse")
+	proto.RegisterType((*SuspendAccountRequest)(nil), "api.SuspendAccountRequest")
+	proto.RegisterType((*SuspendAccountResponse)(nil), "api.SuspendAccountResponse")
+	proto.RegisterType((*UnsuspendAccountRequest)(nil), "api.UnsuspendAccountRequest")
+	proto.RegisterType((*UnsuspendAccountResponse)(nil), "api.UnsuspendAccountResponse")
+	proto.RegisterType((*ForceRemoveZoneRequest)(nil), "api.ForceRemoveZoneRequest")
+	proto.RegisterType((*ForceRemoveZoneResponse)(nil), "api.ForceRemoveZoneResponse")
+	proto.RegisterType((*ReassignZoneRequest)(nil), "api.ReassignZoneRequest")
+	proto.RegisterType((*ReassignZoneResponse)(nil), "api.ReassignZoneResponse")
+}
+
+func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 4180 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3b, 0x5d, 0x73, 0xdb, 0x48,
+	0x72, 0x0b, 0x92, 0xa2, 0xa4, 0xd6, 0x87, 0x47, 0x90, 0x2c, 0x91, 0xf0, 0x37, 0xd6, 0xde, 0x73,
+	0xbc, 0xb7, 0xf2, 0xad, 0x6c, 0xaf, 0x6f, 0x37, 0x71, 0x6e, 0x61, 0x92, 0x92, 0x69, 0x49, 0x14,
+	0x0f, 0xa4, 0xbc, 0xde, 0xe4, 0x2a, 0x29, 0x98, 0x18, 0xc9, 0x38, 0x93, 0x00, 0x0f, 0x00, 0xb5,
+	0xe2, 0x56, 0xae, 0xb2, 0x75, 0x95, 0x3c, 0xa4, 0xf2, 0x03, 0xae, 0x52, 0x95, 0xb7, 0xfc, 0x97,
+	0x54, 0x7e, 0x41, 0x1e, 0xf2, 0x94, 0xaa, 0xfc, 0x87, 0x3c, 0xe5, 0x21, 0xd5, 0x33, 0x83, 0x6f,
+	0x50, 0xd6, 0x22, 0xce, 0x3e, 0x71, 0xa6, 0xbb, 0xa7, 0xa7, 0xa7, 0xa7, 0x67, 0xa6, 0xd1, 0xdd,
+	0x84, 0x45, 0x63, 0x6c, 0x6d, 0x8f, 0x5d, 0xc7, 0x77, 0xe4, 0xb2, 0x31, 0xb6, 0x94, 0x6b, 0xa7,
+	0x8e, 0x73, 0x3a, 0xa4, 0x0f, 0x19, 0xe8, 0xcd, 0xe4, 0xe4, 0x21, 0x1d, 0x8d, 0xfd, 0x29, 0xa7,
+	0x50, 0x15, 0xa8, 0x74, 0x2d, 0xfb, 0x54, 0x96, 0xa1, 0xe2, 0xd3, 0x73, 0xbf, 0x26, 0xdd, 0x96,
+	0xee, 0x2f, 0xea, 0xac, 0xcd, 0x70, 0xce, 0x0c, 0xdc, 0x0b, 0xd8, 0x68, 0xb8, 0xd4, 0xf0, 0xa9,
+	0x36, 0x18, 0x38, 0x13, 0xdb, 0xd7, 0xe9, 0xef, 0x26, 0xd4, 0xf3, 0xe5, 0x0d, 0x98, 0xa3, 0x23,
+	0xc3, 0x1a, 0x0a, 0x62, 0xde, 0x91, 0x15, 0x58, 0x18, 0x1b, 0x9e, 0xf7, 0x9d, 0xe3, 0x9a, 0xb5,
+	0x12, 0x43, 0x84, 0x7d, 0xf5, 0x3f, 0x24, 0xb8, 0x9a, 0x62, 0xe5, 0x8d, 0x1d, 0xdb, 0xa3, 0xf2,
+	0x97, 0x50, 0xf5, 0x7c, 0xc3, 0x9f, 0x78, 0x8c, 0xd9, 0xea, 0xce, 0x9d, 0x6d, 0x5c, 0x59, 0x2e,
+	0xed, 0x76, 0x8f, 0x11, 0xea, 0x62, 0x00, 0x8a, 0xe1, 0x3b, 0xef, 0xa8, 0x2d, 0x66, 0xe3, 0x1d,
+	0x59, 0x85, 0x65, 0x97, 0x9e, 0xb8, 0xd4, 0x7b, 0xdb, 0x67, 0xc8, 0x32, 0x43, 0x26, 0x60, 0xea,
+	0x01, 0x54, 0x39, 0x2f, 0xb9, 0x0a, 0xa5, 0xa3, 0x77, 0xe4, 0x23, 0x79, 0x0b, 0xd6, 0xdb, 0xb6,
+	0x4f, 0x5d, 0xdb, 0x18, 0xf6, 0xa8, 0x7b, 0x46, 0xdd, 0x96, 0xeb, 0x3a, 0x2e, 0x91, 0xe4, 0x55,
+	0x80, 0xe7, 0x86, 0x29, 0x56, 0x4e, 0x4a, 0xf2, 0x1a, 0xac, 0x68, 0x43, 0x97, 0x1a, 0xe6, 0xb4,
+	0x75, 0x6e, 0x79, 0xbe, 0x47, 0xca, 0xea, 0x31, 0x5c, 0x39, 0xa5, 0x3e, 0xe3, 0x5c, 0x58, 0x43,
+	0x32, 0x81, 0xb2, 0xe3, 0x8f, 0x85, 0xb4, 0xd8, 0x54, 0xa7, 0x40, 0x22, 0xb6, 0x42, 0x5b, 0x9f,
+	0xa6, 0xb4, 0xb5, 0xce, 0xb4, 0x15, 0xa0, 0x3f, 0x98, 0x7e, 0x5a, 0x70, 0x75, 0xf0, 0xd6, 0xb0,
+	0x4f, 0x69, 0x57, 0x88, 0x17, 0xac, 0x4b, 0x86, 0x0a, 0x4a, 0x1c, 0x58, 0x09, 0xb6, 0xe5, 0x1a,
+	0xcc, 0x0f, 0x26, 0xae, 0x4b, 0x6d, 0x5f, 0x4c, 0x14, 0x74, 0xd5, 0xbf, 0x85, 0xcd, 0x34, 0x9b,
+	0x9f, 0x76, 0x1d, 0x14, 0xae, 0xb4, 0x6d, 0xcb, 0xff, 0x0b, 0xc7, 0xa6, 0xc1, 0x0a, 0x36, 0xa1,
+	0x6a, 0x3a, 0x23, 0xc3, 0xb2, 0xc5, 0x1a, 0x44, 0x0f, 0xd9, 0x39, 0xee, 0xa9, 0x61, 0x5b, 0xdf,
+	0x1b, 0xbe, 0xe5, 0xf0, 0xb9, 0xca, 0x7a, 0x02, 0x86, 0x63, 0xcf, 0xa8, 0x6b, 0x9d, 0x4c, 0xd9,
+	0x64, 0x0b, 0xba, 0xe8, 0xa9, 0x23, 0x20, 0xd1, 0x34, 0x45, 0x56, 0xf8, 0x73, 0x98, 0x1f, 0x53,
+	0xdb, 0xb4, 0xec, 0x53, 0x36, 0xef, 0xd2, 0x8e, 0xcc, 0xa8, 0xbb, 0x1c, 0xd6, 0x64, 0x12, 0xea,
+	0x01, 0x89, 0xfa, 0x4f, 0x12, 0xac, 0x24, 0x50, 0xb8, 0x2d, 0xb6, 0x31, 0xa2, 0xc1, 0xb6, 0x60,
+	0x5b, 0xbe, 0x0e, 0x8b, 0x83, 0xb7, 0xc6, 0x70, 0x48, 0xed, 0x53, 0x2a, 0x34, 0x17, 0x01, 0x70,
+	0xd3, 0xfc, 0x73, 0xbf, 0x83, 0x83, 0xb8, 0xe2, 0x82, 0xae, 0x7c, 0x13, 0x00, 0xc7, 0x7b, 0xec,
+	0x14, 0xd4, 0x2a, 0x0c, 0x19, 0x83, 0x20, 0x5f, 0x7a, 0x3e, 0xb6, 0x5c, 0xea, 0x69, 0x7e, 0x6d,
+	0x8e, 0x69, 0x29, 0x02, 0xa8, 0x9f, 0xc2, 0xda, 0x2b, 0xa6, 0x94, 0x4b, 0xe8, 0x5c, 0xd5, 0x40,
+	0x8e, 0x13, 0x17, 0xd0, 0x1c, 0xce, 0xa7, 0xd3, 0x91, 0x73, 0x46, 0x2f, 0x39, 0x5f, 0x9c, 0xb8,
+	0xc8, 0x7c, 0xff, 0x28, 0x01, 0xd1, 0x4c, 0x53, 0xa7, 0x83, 0xe4, 0xa9, 0xc8, 0xa8, 0x7f, 0x13,
+	0xaa, 0x8e, 0x6b, 0x9d, 0x5a, 0x81, 0xd5, 0x8a, 0x9e, 0x7c, 0x0b, 0x2a, 0xfe, 0x74, 0xcc, 0xb5,
+	0xbe, 0xba, 0xb3, 0xc4, 0xe7, 0xd2, 0xfb, 0xd3, 0x31, 0xd5, 0x19, 0x02, 0x2f, 0x02, 0xdf, 0x1f,
+	0x32, 0xc5, 0x97, 0x75, 0x6c, 0xb2, 0x03, 0xe6, 0xd8, 0x3e, 0xb5, 0xb9, 0xbe, 0x17, 0xf5, 0xa0,
+	0xab, 0x7e, 0x0d, 0x6b, 0x31, 0x61, 0x8a, 0xac, 0xe7, 0x6f, 0x60, 0x9d, 0xab, 0xe4, 0xff, 0x71,
+	0x45, 0x31, 0xf9, 0x2b, 0x49, 0xf9, 0x1b, 0xb0, 0x91, 0x9c, 0xbd, 0xc8, 0x12, 0xfe, 0xab, 0x04,
+	0xeb, 0xc7, 0x63, 0xd3, 0xf0, 0x53, 0x6b, 0x88, 0xe4, 0x95, 0x12, 0xf2, 0x3e, 0x85, 0xaa, 0x6f,
+	0xb8, 0xa7, 0xd4, 0x17, 0x67, 0xed, 0x16, 0x63, 0x9e, 0xc3, 0x61, 0xbb, 0xcf, 0xc8, 0x74, 0x41,
+	0x8e, 0x03, 0x3d, 0x67, 0xe2, 0x0e, 0xf8, 0x52, 0x2f, 0x1a, 0xd8, 0x63, 0x64, 0xba, 0x20, 0x57,
+	0xbe, 0x81, 0x2a, 0x67, 0x95, 0xab, 0xd7, 0x40, 0x7f, 0xa5, 0x4b, 0xe8, 0xaf, 0x9c, 0xd0, 0x9f,
+	0x62, 0x41, 0x95, 0x4f, 0xf5, 0x81, 0x19, 0x67, 0x8d, 0x10, 0xb7, 0x2a, 0xb9, 0xd2, 0x22, 0x5b,
+	0xf5, 0x16, 0xe4, 0x3d, 0xea, 0xf3, 0x4b, 0xcb, 0x2b, 0x76, 0x55, 0xde, 0x83, 0x79, 0x7e, 0x9a,
+	0xbd, 0x5a, 0xe9, 0x76, 0xf9, 0xfe, 0x92, 0x58, 0x57, 0x70, 0x47, 0x0a, 0x9c, 0xda, 0x85, 0x2a,
+	0x07, 0xc9, 0xab, 0x50, 0xb2, 0x4c, 0xc6, 0xb9, 0xac, 0x97, 0x2c, 0x33, 0xd4, 0x54, 0x29, 0xa6,
+	0xa9, 0xf4, 0xe5, 0x5f, 0xce, 0x5e, 0xfe, 0x78, 0xd3, 0xec, 0x51, 0x9f, 0xaf, 0xde, 0x7b, 0x8f,
+	0x8d, 0x89, 0x85, 0x86, 0xc4, 0x05, 0x17, 0xea, 0xf2, 0xf1, 0x89, 0x85, 0x0a, 0xf5, 0x07, 0x38,
+	0xd5, 0x82, 0x2a, 0x07, 0x15, 0x33, 0x01, 0xb1, 0xd1, 0xe5, 0xdc, 0xdb, 0x26, 0x75, 0x5a, 0x07,
+	0xb0, 0xd6, 0x1e, 0x8d, 0x1d, 0xf7, 0x52, 0xef, 0xa9, 0x0c, 0x95, 0xef, 0x1d, 0x3b, 0x54, 0x33,
+	0xb6, 0x2f, 0xa5, 0xe6, 0x23, 0x90, 0xe3, 0x93, 0x14, 0xd0, 0xdc, 0xcb, 0xca, 0x42, 0x89, 0x94,
+	0xf5, 0x2a, 0x45, 0x0f, 0xce, 0x53, 0xff, 0x4e, 0x82, 0xb5, 0xd6, 0x79, 0x8e, 0xd8, 0xb9, 0x97,
+	0xc3, 0x13, 0xa8, 0x9e, 0x38, 0xee, 0xc8, 0xf0, 0x85, 0xca, 0x6e, 0xb0, 0x89, 0x32, 0xe3, 0xb7,
+	0x77, 0x19, 0x91, 0x2e, 0x88, 0xd5, 0xdb, 0x50, 0xe5, 0x10, 0x79, 0x19, 0x16, 0x90, 0x6e, 0xd7,
+	0x1a, 0x52, 0xf2, 0x91, 0xbc, 0x00, 0x95, 0x97, 0x9e, 0x63, 0x13, 0x49, 0x3d, 0x06, 0x39, 0xce,
+	0xa5, 0x88, 0x45, 0xe4, 0xa8, 0x54, 0xfd, 0x35, 0xac, 0x6b, 0xe3, 0xf1, 0x70, 0xda, 0x60, 0x7e,
+	0xd6, 0xfb, 0xec, 0x52, 0x56, 0xa1, 0xea, 0xba, 0x1e, 0xf5, 0x03, 0x9b, 0x02, 0x61, 0x11, 0x3d,
+	0xbc, 0xe6, 0x38, 0x46, 0xfd, 0x37, 0x09, 0xe6, 0x18, 0xe4, 0x43, 0x59, 0xd4, 0x13, 0x00, 0xee,
+	0x06, 0xb2, 0x81, 0x15, 0x36, 0xf0, 0x6a, 0x34, 0xf1, 0x36, 0x97, 0x9d, 0xb1, 0x88, 0x11, 0xa2,
+	0xb7, 0x2c, 0x2c, 0xcf, 0xab, 0xcd, 0xdd, 0x2e, 0xa3, 0xb7, 0x1c, 0xf4, 0xd5, 0x7b, 0x00, 0xd1,
+	0x28, 0x79, 0x09, 0xe6, 0xf5, 0x56, 0xf7, 0x40, 0x6b, 0xb4, 0xc8, 0x47, 0x32, 0x40, 0xb5, 0xd9,
+	0x3a, 0x68, 0xf5, 0x5b, 0x44, 0xc2, 0x4b, 0x2b, 0xa9, 0x9d, 0x22, 0x97, 0xd6, 0x97, 0xf8, 0x44,
+	0x46, 0x4e, 0x65, 0xa0, 0xe2, 0xb4, 0xff, 0x29, 0xe5, 0xf8, 0x9f, 0xbf, 0xc7, 0xf7, 0x2d, 0x3e,
+	0xf4, 0xa7, 0x75, 0x7f, 0x7b, 0xb0, 0x72, 0xe0, 0x9c, 0x3a, 0x13, 0xff, 0x47, 0xc8, 0x8c, 0xfe,
+	0x1f, 0x3d, 0xa3, 0xee, 0xf4, 0xbb, 0xb7, 0xd4, 0xe5, 0xdb, 0xbc, 0xa0, 0xc7, 0x20, 0xea, 0x33,
+	0x58, 0x0d, 0x98, 0x16, 0xd1, 0xe6, 0x1f, 0x25, 0x28, 0xbf, 0xfc, 0x66, 0x1f, 0xcd, 0xe4, 0x9d,
+	0x3f, 0x15, 0x12, 0x60, 0x93, 0x41, 0xac, 0xe0, 0xc3, 0x08, 0x9b, 0x08, 0x99, 0x78, 0x81, 0x83,
+	0x8a, 0x4d, 0x84, 0x18, 0xc3, 0x53, 0x71, 0x31, 0x61, 0x53, 0x5e, 0x06, 0xc9, 0x16, 0x6e, 0x91,
+	0x64, 0x63, 0x8f, 0xd6, 0xaa, 0xbc, 0xc7, 0xa8, 0x07, 0xee, 0x59, 0x6d, 0x9e, 0x53, 0x0f, 0xdc,
+	0x33, 0xc4, 0x9f, 0xd7, 0x16, 0x38, 0xfe, 0x1c, 0x7b, 0xd3, 0xda, 0x22, 0xef, 0x4d, 0xd5, 0xdf,
+	0xc0, 0x95, 0x3d, 0xea, 0xbf, 0xfc, 0x66, 0xbf, 0x57, 0x6c, 0x9f, 0xae, 0x43, 0xe5, 0x1d, 0x9d,
+	0x06, 0x27, 0x6b, 0x81, 0x91, 0xbe, 0xfc, 0x66, 0x5f, 0x67, 0x50, 0xf5, 0x3f, 0x25, 0xa8, 0x6a,
+	0xdd, 0xf6, 0x3e, 0x9d, 0x5e, 0xea, 0x45, 0xfa, 0x04, 0xe6, 0xbc, 0x81, 0x13, 0x7a, 0x55, 0x84,
+	0x71, 0xe3, 0xe3, 0x7b, 0x08, 0xd7, 0x39, 0x1a, 0x8d, 0x03, 0xef, 0x01, 0xaf, 0x56, 0x61, 0x27,
+	0x84, 0x77, 0x2e, 0xf6, 0xd1, 0x71, 0x87, 0x87, 0x86, 0xe7, 0x1f, 0x7b, 0xd4, 0xd4, 0x7c, 0xa6,
+	0xad, 0xb2, 0x1e, 0x83, 0xe0, 0xe8, 0x01, 0xfb, 0xfe, 0x46, 0xf4, 0x3c, 0x1f, 0x1d, 0x02, 0xf0,
+	0x7d, 0x70, 0xe9, 0x99, 0xf3, 0x8e, 0x9a, 0x4c, 0x91, 0x0b, 0x7a, 0xd0, 0x55, 0xa7, 0xb0, 0x2e,
+	0xbe, 0xdb, 0x99, 0x9c, 0x17, 0xf9, 0x92, 0xe1, 0xf2, 0x4a, 0x97, 0x5c, 0x5e, 0x39, 0xbe, 0xbc,
+	0xac, 0x77, 0xf2, 0x7d, 0x18, 0xa9, 0x10, 0x53, 0x17, 0xd9, 0xc0, 0x1b, 0x50, 0x7e, 0x47, 0xa7,
+	0xc2, 0x2b, 0x5c, 0x8a, 0x89, 0xa4, 0x23, 0x1c, 0xef, 0x54, 0x8f, 0x0e, 0x5c, 0x1a, 0x38, 0x4b,
+	0xa2, 0xa7, 0x0e, 0x60, 0xfd, 0xc0, 0xf2, 0x7c, 0x4e, 0x5a, 0xf0, 0xb1, 0xbf, 0x95, 0xb0, 0x9d,
+	0xc4, 0xdc, 0xdc, 0x7c, 0xee, 0xe1, 0x25, 0x84, 0x6a, 0x4e, 0xea, 0x36, 0x65, 0x4a, 0xdc, 0xa1,
+	0x8e, 0x93, 0x15, 0x39, 0xa2, 0xbf, 0x86, 0xe5, 0xa3, 0xf8, 0x67, 0xef, 0x65, 0xec, 0xf5, 0x06,
+	0x54, 0x5c, 0x67, 0x18, 0x98, 0xeb, 0x22, 0x67, 0xef, 0x0c, 0xa9, 0xce, 0xc0, 0xea, 0x33, 0xa8,
+	0x1e, 0xd2, 0xd1, 0x1b, 0xea, 0xce, 0x88, 0x8c, 0x04, 0xc3, 0x4b, 0xf9, 0xc3, 0x1f, 0x42, 0x9d,
+	0x6f, 0x6f, 0x5c, 0xae, 0x0b, 0xec, 0x4b, 0xfd, 0x41, 0x02, 0x25, 0x6f, 0x44, 0x91, 0xbd, 0x79,
+	0x92, 0x13, 0x19, 0x58, 0xda, 0x59, 0x63, 0x43, 0x12, 0xdc, 0x93, 0x8e, 0xcc, 0x0f, 0x12, 0xd4,
+	0xf6, 0xa8, 0x1f, 0xa7, 0x28, 0x68, 0x1c, 0x4f, 0x61, 0x25, 0xce, 0x39, 0xb0, 0x92, 0x1c, 0x09,
+	0x92, 0x74, 0xea, 0x53, 0xe6, 0xb2, 0x72, 0xc5, 0x7b, 0xb1, 0x37, 0x20, 0xb1, 0x1c, 0x29, 0xc7,
+	0x09, 0xe3, 0xee, 0x6b, 0x38, 0xb0, 0xa0, 0xfb, 0x3a, 0xe2, 0xe3, 0x13, 0x46, 0xcd, 0x79, 0xea,
+	0x01, 0x4e, 0xb5, 0x31, 0xee, 0x76, 0x66, 0xf9, 0x54, 0x20, 0x2e, 0x2f, 0x64, 0x64, 0x49, 0xa5,
+	0x3c, 0x4b, 0x9a, 0x61, 0x88, 0x0d, 0xd8, 0x48, 0xce, 0x57, 0xe4, 0x80, 0x1c, 0x05, 0x1f, 0xcd,
+	0x1f, 0x48, 0xe8, 0xe8, 0x3b, 0xf8, 0xff, 0x22, 0xd5, 0xef, 0x61, 0x11, 0x7d, 0xcb, 0xde, 0x5b,
+	0xc3, 0xa5, 0x85, 0x8e, 0x19, 0x5e, 0xed, 0xde, 0xe4, 0x8d, 0xef, 0xd2, 0x30, 0x28, 0x24, 0xba,
+	0xf2, 0x1d, 0x98, 0x43, 0x9f, 0x8c, 0x3f, 0x33, 0x29, 0xb7, 0x8f, 0x63, 0xd4, 0x2e, 0x10, 0x36,
+	0xf5, 0x65, 0x3e, 0x0e, 0xee, 0xc2, 0x9c, 0x87, 0xb4, 0xe2, 0x2c, 0xad, 0x32, 0x76, 0xa1, 0xf0,
+	0x3a, 0x47, 0x62, 0x74, 0x23, 0xc6, 0xb1, 0x88, 0x4a, 0x9e, 0x83, 0x7c, 0x6c, 0x7b, 0x97, 0x95,
+	0x2a, 0x7f, 0x6f, 0x9e, 0xc3, 0x7a, 0x82, 0x47, 0x11, 0x39, 0x1e, 0xc2, 0x55, 0x7c, 0x22, 0xc2,
+	0x15, 0x7a, 0xef, 0x8b, 0x54, 0x8d, 0x60, 0x33, 0x3d, 0xa0, 0xc8, 0x21, 0xfc, 0x04, 0xaa, 0x4c,
+	0xf2, 0xe0, 0x0c, 0xa6, 0x15, 0x2d, 0xb0, 0xea, 0xff, 0x48, 0xb0, 0x8c, 0xd0, 0xbe, 0x6b, 0xd8,
+	0xde, 0x09, 0x75, 0x33, 0x57, 0x7e, 0x24, 0x67, 0x29, 0xfd, 0x95, 0x77, 0xe2, 0x3a, 0x23, 0x61,
+	0x2e, 0xac, 0x8d, 0x63, 0x7d, 0x47, 0xb8, 0x68, 0x25, 0xdf, 0x91, 0x3f, 0x83, 0x39, 0x14, 0x87,
+	0x32, 0x47, 0x64, 0x75, 0x67, 0x2b, 0x94, 0x21, 0x98, 0x8d, 0xc5, 0xf5, 0x71, 0xd7, 0xf1, 0x27,
+	0xe9, 0x7d, 0x54, 0xd3, 0xde, 0xc7, 0x6d, 0x58, 0x1a, 0x38, 0xa3, 0xf1, 0x90, 0xc6, 0xbd, 0x93,
+	0x38, 0x48, 0xfd, 0x1c, 0xe6, 0x18, 0x3f, 0xfc, 0x2a, 0x10, 0x51, 0x52, 0xf2, 0x11, 0x7e, 0x96,
+	0x69, 0x83, 0x01, 0x1d, 0xfb, 0xd4, 0x24, 0x92, 0xbc, 0x02, 0x8b, 0x0d, 0xc3, 0x1e, 0xd0, 0xe1,
+	0x90, 0x9a, 0xa4, 0xa4, 0x36, 0x60, 0x3d, 0x90, 0xa5, 0xb8, 0x9d, 0xb8, 0xb0, 0x91, 0x64, 0x52,
+	0x64, 0xc3, 0x3e, 0x83, 0x05, 0x5f, 0x30, 0x49, 0xbc, 0x33, 0x71, 0x75, 0xe9, 0x21, 0x89, 0xfa,
+	0x29, 0xd4, 0xf9, 0xaa, 0x12, 0xf8, 0x19, 0xbe, 0x41, 0x1b, 0x94, 0x3c, 0xe2, 0x62, 0x51, 0xd7,
+	0x3a, 0xd7, 0xdf, 0x25, 0xe7, 0xcd, 0x23, 0x2e, 0x32, 0xef, 0x14, 0xea, 0xc1, 0xb1, 0x08, 0x18,
+	0x15, 0x3c, 0x19, 0x0f, 0x61, 0x31, 0xd0, 0x62, 0xf2, 0x3d, 0x4d, 0x08, 0x19, 0xd1, 0xa8, 0xef,
+	0x40, 0x6e, 0xd9, 0xae, 0x33, 0x1c, 0xf6, 0x8f, 0xfa, 0xdd, 0x62, 0x73, 0x46, 0x0e, 0x64, 0x29,
+	0xee, 0x40, 0xb2, 0xcf, 0x1c, 0xd7, 0x0a, 0x3f, 0x73, 0x5c, 0x4b, 0xbd, 0x0f, 0x72, 0xc3, 0xb1,
+	0x4f, 0x2c, 0x77, 0xc4, 0x67, 0x0b, 0x1d, 0x9d, 0x81, 0x63, 0x86, 0x8e, 0x0e, 0xb6, 0xd5, 0xb7,
+	0xb0, 0x9e, 0xa0, 0x2c, 0x22, 0xd7, 0x5d, 0x58, 0xc1, 0x68, 0x12, 0x7e, 0xe2, 0x35, 0x1c, 0x53,
+	0x5c, 0x16, 0x8b, 0x7a, 0x12, 0x88, 0x32, 0x35, 0x2d, 0xcf, 0x78, 0x33, 0xa4, 0xef, 0x93, 0xe9,
+	0x39, 0xac, 0x27, 0x28, 0x8b, 0xec, 0xf4, 0x23, 0xb8, 0x26, 0xa6, 0x88, 0xe5, 0x8e, 0xe8, 0xc5,
+	0x19, 0x48, 0x75, 0x1f, 0xae, 0xe7, 0x0f, 0x2a, 0x22, 0xc1, 0x0b, 0x7c, 0x93, 0x3d, 0xea, 0xa7,
+	0x53, 0x60, 0xe1, 0xe7, 0xb8, 0x14, 0xff, 0x1c, 0xbf, 0x28, 0xf9, 0xd9, 0x84, 0xab, 0x29, 0x4e,
+	0x45, 0xe4, 0x79, 0x10, 0x24, 0x4b, 0x5a, 0xb8, 0xd6, 0x0b, 0xa5, 0xc1, 0x1d, 0x48, 0xd0, 0x16,
+	0x99, 0xaf, 0x0d, 0x0a, 0x4a, 0x6d, 0x9b, 0x8c, 0x93, 0x35, 0x28, 0xee, 0x41, 0xab, 0xbb, 0xb0,
+	0x71, 0x6c, 0x0f, 0x9d, 0xc1, 0xbb, 0x4b, 0xe5, 0x91, 0x6b, 0x30, 0x6f, 0x98, 0xa6, 0x4b, 0x3d,
+	0x2f, 0xc8, 0x27, 0x8a, 0x2e, 0x2a, 0x32, 0xc5, 0xa7, 0x88, 0x34, 0x7f, 0x05, 0x1b, 0x4d, 0x8a,
+	0xaf, 0x45, 0x4a, 0x9a, 0xf8, 0x16, 0x4a, 0xf9, 0xd9, 0xd9, 0x52, 0x98, 0x9d, 0x45, 0x29, 0x2d,
+	0x33, 0x1e, 0x7a, 0x09, 0xba, 0x28, 0x65, 0x8a, 0x7f, 0x11, 0x29, 0x7f, 0x03, 0x75, 0x1e, 0x2f,
+	0x14, 0x5c, 0x9a, 0x86, 0x6f, 0x14, 0x0e, 0x1b, 0x9a, 0x86, 0x6f, 0x30, 0xe1, 0x97, 0x75, 0xd6,
+	0x56, 0x77, 0xa1, 0xd6, 0x3a, 0xe7, 0xb1, 0xb6, 0xa3, 0x76, 0xb3, 0x91, 0x08, 0x6c, 0xc5, 0x56,
+	0x26, 0x25, 0x56, 0x96, 0xd5, 0x82, 0xfa, 0x07, 0x09, 0xea, 0x39, 0x8c, 0x7e, 0xda, 0x30, 0xd7,
+	0x1f, 0x25, 0x58, 0x6b, 0x0c, 0x2d, 0x6a, 0xfb, 0x0d, 0xea, 0xfa, 0xdc, 0x56, 0x03, 0x67, 0xf6,
+	0xb7, 0x74, 0x10, 0xd4, 0x34, 0x04, 0xdd, 0xdc, 0xef, 0xd7, 0x84, 0xd7, 0x51, 0x4e, 0x7b, 0x1d,
+	0xc9, 0x88, 0x49, 0x25, 0x13, 0x31, 0xd9, 0x84, 0xaa, 0xe5, 0x79, 0x13, 0xea, 0x8a, 0x48, 0x94,
+	0xe8, 0xa9, 0x5f, 0xc0, 0x6d, 0x9d, 0x9e, 0x5a, 0x9e, 0x4f, 0xdd, 0x8c, 0x80, 0x17, 0x7d, 0xbe,
+	0xfe, 0x41, 0x82, 0x3b, 0x17, 0x0c, 0x2c, 0xa2, 0xde, 0x98, 0x3a, 0x4a, 0x49, 0x75, 0x44, 0xc2,
+	0x97, 0x13, 0xc2, 0xff, 0x83, 0x04, 0x37, 0xf1, 0xb5, 0xcd, 0x08, 0x50, 0xf0, 0xc9, 0xfd, 0x0a,
+	0x96, 0x07, 0x31, 0x26, 0xe2, 0xd5, 0xdd, 0x64, 0x43, 0xb2, 0x8b, 0x4c, 0xd0, 0xaa, 0x3a, 0xdc,
+	0xe4, 0x1f, 0x48, 0x33, 0xd5, 0x38, 0x7b, 0xbb, 0xa3, 0xf5, 0x95, 0x12, 0xeb, 0xeb, 0xc0, 0xad,
+	0x99, 0x3c, 0x8b, 0x9c, 0xd8, 0x7f, 0x97, 0x00, 0xb4, 0x89, 0x69, 0xf9, 0xad, 0x33, 0x6a, 0x67,
+	0xdc, 0xa0, 0x19, 0x9f, 0xab, 0x9b, 0x50, 0x35, 0xc6, 0xd6, 0x3e, 0x9d, 0x0a, 0xa3, 0x13, 0x3d,
+	0x84, 0x8f, 0xa8, 0xff, 0xd6, 0x31, 0x85, 0x23, 0x2d, 0x7a, 0x61, 0x0e, 0x60, 0x2e, 0x96, 0x56,
+	0xa9, 0xc1, 0xfc, 0xd8, 0x98, 0x0e, 0x1d, 0xc3, 0x14, 0xa1, 0xcf, 0xa0, 0x1b, 0xbe, 0xce, 0xf3,
+	0xd1, 0xeb, 0x8c, 0xb0, 0x31, 0xa5, 0xae, 0x88, 0x82, 0xb2, 0x76, 0xd2, 0xfa, 0x17, 0x53, 0xd6,
+	0xaf, 0xfe, 0xb3, 0xc4, 0xbf, 0x46, 0xa2, 0xc5, 0x79, 0x31, 0xe3, 0x65, 0xe2, 0x48, 0x31, 0x71,
+	0xf2, 0x17, 0xba, 0x01, 0x73, 0x9e, 0x65, 0x8b, 0xdc, 0x69, 0x59, 0xe7, 0x1d, 0x84, 0x4e, 0x6c,
+	0xdf, 0x0a, 0x62, 0x79, 0xbc, 0x83, 0xd0, 0xa1, 0x35, 0xb2, 0x78, 0xe8, 0x72, 0x4e, 0xe7, 0x1d,
+	0x96, 0xd3, 0x38, 0x39, 0xf1, 0x28, 0xff, 0x2a, 0x98, 0xd3, 0x45, 0x4f, 0x75, 0x60, 0x2b, 0x23,
+	0x5d, 0x11, 0xfb, 0xfc, 0x19, 0x54, 0x29, 0x1b, 0x2e, 0x2c, 0xf3, 0x0a, 0x23, 0x8e, 0xd8, 0xea,
+	0x02, 0xad, 0xfe, 0xb7, 0x04, 0xab, 0xe2, 0x56, 0xee, 0x4d, 0x46, 0x23, 0xc3, 0x9d, 0x5e, 0x72,
+	0xb3, 0xef, 0xc2, 0x0a, 0x6b, 0xf0, 0x17, 0x95, 0x9a, 0xa2, 0x8c, 0x24, 0x09, 0xc4, 0xb1, 0x86,
+	0x39, 0xb2, 0x6c, 0xa6, 0x93, 0x05, 0x9d, 0x77, 0xf0, 0xc3, 0xc7, 0x77, 0xfc, 0x71, 0xcb, 0x46,
+	0xbf, 0xca, 0x64, 0x9a, 0x59, 0xd0, 0xe3, 0x20, 0xa4, 0xf0, 0x26, 0xde, 0x98, 0xda, 0x66, 0xec,
+	0xd3, 0x29, 0x0e, 0x92, 0xef, 0xc3, 0x95, 0xb0, 0xab, 0x53, 0xc3, 0x73, 0x6c, 0x61, 0x19, 0x69,
+	0x70, 0x14, 0x77, 0x5d, 0xe0, 0xfb, 0xc2, 0x3a, 0xea, 0xb7, 0x22, 0xd2, 0xc9, 0xd7, 0xee, 0xc5,
+	0x9e, 0xf1, 0xdf, 0x4d, 0xa8, 0x1b, 0x04, 0xf3, 0x79, 0x27, 0xda, 0xc4, 0x52, 0xfe, 0x26, 0x96,
+	0x13, 0x9b, 0xe8, 0xc3, 0x46, 0x92, 0x75, 0x31, 0xa7, 0x7e, 0xc1, 0x10, 0x0c, 0xc4, 0x1e, 0x72,
+	0xf2, 0xe4, 0x66, 0xe9, 0x21, 0x91, 0xfa, 0xf7, 0x12, 0x2c, 0xb1, 0xaf, 0xe1, 0x19, 0xdb, 0x98,
+	0xf7, 0x52, 0x6c, 0xc0, 0x9c, 0xf3, 0x9d, 0x1d, 0xde, 0x96, 0xbc, 0x93, 0x89, 0xfd, 0xf0, 0x53,
+	0x9b, 0x80, 0xf1, 0xc8, 0x39, 0xcf, 0xe8, 0xf2, 0x98, 0x7c, 0xd0, 0x55, 0x87, 0x40, 0x82, 0xef,
+	0x9a, 0xf7, 0x6b, 0x35, 0xff, 0x70, 0x71, 0x5d, 0x97, 0xf3, 0x75, 0x5d, 0x49, 0xe8, 0xfa, 0x2d,
+	0xac, 0xc5, 0x66, 0x2b, 0x16, 0x57, 0x10, 0xe6, 0xc1, 0xb5, 0x4c, 0xa2, 0xb0, 0x82, 0x50, 0xb1,
+	0x30, 0x98, 0x07, 0x2c, 0x99, 0x4b, 0x5d, 0xcf, 0xb1, 0x63, 0x57, 0x75, 0xbe, 0xf3, 0xfe, 0x1a,
+	0xd6, 0x13, 0xb4, 0x1f, 0xcc, 0x87, 0xc0, 0x6a, 0xb6, 0x1e, 0xb7, 0xef, 0x4b, 0xf9, 0x9f, 0x9b,
+	0x50, 0x75, 0xf9, 0xe1, 0x10, 0xef, 0x05, 0xef, 0xa9, 0x2d, 0xd8, 0x4c, 0xb3, 0x29, 0x16, 0x0b,
+	0xda, 0x3a, 0xb6, 0xbd, 0xcb, 0xcb, 0xa3, 0xee, 0x41, 0x2d, 0x3b, 0xa0, 0xc8, 0xcc, 0xbf, 0x80,
+	0xcd, 0x5d, 0xc7, 0x1d, 0xd0, 0xcb, 0x17, 0x4c, 0xed, 0xc2, 0x56, 0x66, 0x44, 0x91, 0x99, 0x1b,
+	0x18, 0x30, 0x35, 0x3c, 0xcf, 0x3a, 0xb5, 0x8b, 0x07, 0x58, 0x58, 0x90, 0x34, 0xce, 0xa4, 0x80,
+	0x24, 0x0f, 0xfa, 0xb0, 0x14, 0x4b, 0x33, 0xc9, 0x57, 0x60, 0xe9, 0xd8, 0xf6, 0xc6, 0x74, 0xc0,
+	0xae, 0x5e, 0x1e, 0x27, 0xd2, 0xa9, 0x61, 0x1e, 0xd9, 0xc3, 0x29, 0x91, 0x10, 0x2d, 0x6a, 0x38,
+	0x18, 0xa0, 0x84, 0x31, 0x25, 0x6d, 0x30, 0xa2, 0xfd, 0xd7, 0x7d, 0x52, 0xc6, 0xe4, 0xfe, 0xee,
+	0x64, 0x38, 0x24, 0x95, 0x07, 0x8f, 0xa1, 0x82, 0x61, 0x54, 0xcc, 0x3d, 0xbf, 0xb2, 0xe8, 0x77,
+	0xd4, 0xe5, 0x79, 0xe8, 0x96, 0x69, 0xf9, 0xac, 0x88, 0x74, 0x11, 0xe6, 0x34, 0xbc, 0xc5, 0x49,
+	0x09, 0x9b, 0x47, 0x78, 0x63, 0x90, 0xf2, 0x03, 0x0d, 0x56, 0x93, 0x52, 0xfe, 0xe8, 0x6a, 0xd4,
+	0x07, 0xff, 0x52, 0x85, 0x2a, 0x0f, 0xc3, 0xca, 0x73, 0x20, 0x69, 0xbc, 0xe2, 0x40, 0xd3, 0x34,
+	0x4d, 0x4c, 0xba, 0xdb, 0x6b, 0x3e, 0x27, 0x25, 0x79, 0x1e, 0xca, 0x5a, 0xe7, 0x5b, 0x2e, 0xb2,
+	0xd6, 0x3f, 0xd4, 0x48, 0x85, 0x81, 0x5e, 0x35, 0xc8, 0x1c, 0x03, 0xbd, 0xde, 0xd5, 0x49, 0x15,
+	0x41, 0x0d, 0x4d, 0x23, 0xf3, 0xb8, 0xca, 0x46, 0xb3, 0xd3, 0xdb, 0x6f, 0x7d, 0x4b, 0x16, 0x18,
+	0xb4, 0xd9, 0x23, 0x8b, 0x48, 0xd8, 0x68, 0xe9, 0x7d, 0x02, 0xc8, 0xb9, 0xd1, 0xd1, 0x0e, 0x5b,
+	0x64, 0x89, 0x35, 0x7b, 0xdf, 0x76, 0x1a, 0x64, 0x19, 0x9b, 0xcd, 0x17, 0x8d, 0x76, 0x93, 0xac,
+	0xe0, 0x98, 0xe6, 0xc1, 0x2b, 0xb2, 0xca, 0x60, 0x8c, 0xf2, 0x0a, 0xcb, 0xcb, 0x73, 0x9e, 0x04,
+	0xd7, 0xd9, 0xec, 0x91, 0x35, 0xa4, 0x6b, 0xb5, 0x9b, 0x44, 0x46, 0xba, 0xd6, 0x71, 0xfb, 0xf1,
+	0x2f, 0xc9, 0xba, 0x68, 0x7e, 0xf1, 0x98, 0x6c, 0x20, 0x7a, 0xaf, 0xdd, 0x24, 0x57, 0x71, 0xea,
+	0xbd, 0xee, 0x51, 0x8f, 0x6c, 0x22, 0xf6, 0x45, 0xbb, 0xb3, 0x7b, 0x44, 0xb6, 0x10, 0xfb, 0xa2,
+	0xdd, 0x25, 0x35, 0xc4, 0xb6, 0x7b, 0xcd, 0x0e, 0xa9, 0xb3, 0x16, 0xae, 0x45, 0x41, 0x24, 0x4e,
+	0x75, 0x0d, 0xa7, 0xda, 0x7f, 0x4d, 0xae, 0x23, 0xe0, 0xe0, 0xd1, 0x0e, 0xb9, 0xc1, 0x1a, 0x5f,
+	0x3c, 0x26, 0x37, 0x59, 0xe3, 0xa8, 0x41, 0x6e, 0x21, 0xc9, 0x41, 0x97, 0xdc, 0x46, 0xde, 0x87,
+	0x5a, 0xfb, 0x40, 0x23, 0x77, 0x82, 0xe6, 0x73, 0xa2, 0x22, 0xf6, 0xf0, 0x39, 0xf9, 0x98, 0xfd,
+	0x36, 0xc9, 0x5d, 0xf6, 0xbb, 0x4b, 0xee, 0xb1, 0xdf, 0x3d, 0xf2, 0x09, 0x23, 0x65, 0x12, 0xfd,
+	0x8c, 0x81, 0x74, 0x72, 0x9f, 0xfd, 0xbe, 0x26, 0x7f, 0x82, 0xa8, 0x8e, 0xd6, 0xed, 0xeb, 0xe4,
+	0x01, 0x4e, 0xd6, 0x69, 0x37, 0xc9, 0xa7, 0xa8, 0x86, 0x4e, 0xfb, 0x10, 0x27, 0xfe, 0x39, 0xc3,
+	0xb3, 0xa1, 0x9f, 0xe1, 0x90, 0x4e, 0x8f, 0x6c, 0xe3, 0x0a, 0x3a, 0xbd, 0x56, 0x83, 0x3c, 0x64,
+	0xc8, 0x5e, 0xab, 0xf1, 0x88, 0xfc, 0x02, 0x77, 0x9d, 0x35, 0xbb, 0x9a, 0xae, 0x1d, 0x92, 0xcf,
+	0x19, 0xd1, 0xf1, 0xc1, 0x01, 0xd9, 0x61, 0x6c, 0x5f, 0xf7, 0xc9, 0x23, 0x06, 0x72, 0x6c, 0x4a,
+	0x1e, 0x23, 0xf1, 0x51, 0xb7, 0xd5, 0xe9, 0xee, 0x75, 0x51, 0x01, 0x4f, 0x90, 0xe4, 0xa8, 0xdb,
+	0x27, 0x5f, 0x60, 0x03, 0x65, 0x79, 0x8a, 0x73, 0x75, 0x5f, 0x93, 0x5f, 0xe2, 0x18, 0x1d, 0x69,
+	0xbe, 0x44, 0x88, 0xde, 0x25, 0x5f, 0xe1, 0x9c, 0xba, 0xde, 0x6b, 0xef, 0x91, 0x3f, 0x65, 0xa0,
+	0x3e, 0xf9, 0x33, 0x7e, 0x20, 0x58, 0xe9, 0xa7, 0x49, 0x9e, 0x21, 0x0f, 0x44, 0xff, 0x39, 0x2e,
+	0xa3, 0x77, 0xd8, 0x3e, 0x6c, 0x69, 0xe4, 0x57, 0x0c, 0x78, 0xa4, 0x91, 0xaf, 0x59, 0xa3, 0xbb,
+	0x4b, 0x34, 0xd6, 0xd0, 0x5f, 0x91, 0xe7, 0xc8, 0xb0, 0xd7, 0x7b, 0xb1, 0xdb, 0x25, 0x0d, 0x64,
+	0xd8, 0xd7, 0x48, 0x13, 0x47, 0xf6, 0xb5, 0x83, 0x76, 0x67, 0x9f, 0xb4, 0x50, 0x82, 0x3e, 0x4a,
+	0xb0, 0xcb, 0x5a, 0x07, 0x3d, 0x8d, 0xec, 0xb1, 0x16, 0xce, 0xf1, 0x02, 0xb9, 0xe0, 0x41, 0x6b,
+	0x63, 0xe3, 0xb8, 0xdd, 0x24, 0x2f, 0x91, 0xdd, 0x31, 0x53, 0xd8, 0x3e, 0xb2, 0x39, 0xee, 0xf4,
+	0xba, 0xad, 0x06, 0x39, 0x60, 0x78, 0xbd, 0x4d, 0x0e, 0xb1, 0xf1, 0x7a, 0xe7, 0x09, 0xe9, 0xa0,
+	0xd4, 0x9d, 0x9e, 0xd6, 0xfd, 0x6b, 0x5c, 0xf0, 0xd1, 0xce, 0xbf, 0x2a, 0xb0, 0xd4, 0x35, 0x6d,
+	0x0f, 0xcf, 0x92, 0x35, 0xc0, 0x8f, 0xbd, 0xca, 0x18, 0xeb, 0xe1, 0x79, 0x02, 0x04, 0x4b, 0xe3,
+	0x15, 0xd1, 0xc4, 0x4a, 0xf8, 0x5d, 0x58, 0x19, 0xc4, 0xcb, 0xcf, 0xe5, 0x7a, 0x5e, 0x49, 0x3a,
+	0x3b, 0x81, 0x8a, 0x32, 0xbb, 0x5a, 0x5d, 0x7e, 0x0a, 0x0b, 0x41, 0xfd, 0xb6, 0xbc, 0xc1, 0xe8,
+	0x52, 0x55, 0xe2, 0xca, 0xd5, 0x14, 0x54, 0x0c, 0x6c, 0xc3, 0x6a, 0xb2, 0x6c, 0x5a, 0xe6, 0xd3,
+	0xe4, 0x96, 0x64, 0x2b, 0xd7, 0x72, 0x71, 0x91, 0x0c, 0x96, 0xa8, 0x4c, 0x16, 0x32, 0xa4, 0xea,
+	0xa1, 0x95, 0xab, 0x29, 0xa8, 0x18, 0xf8, 0x0c, 0xc0, 0x0d, 0x2f, 0x7d, 0x79, 0x53, 0x5c, 0xa9,
+	0xa9, 0x77, 0x43, 0xd9, 0xca, 0xc0, 0xc5, 0xf0, 0xaf, 0x60, 0xd1, 0x08, 0x0a, 0x53, 0x65, 0x3e,
+	0x45, 0xba, 0x6a, 0x56, 0xd9, 0x4c, 0x83, 0xc5, 0xd8, 0x06, 0x7e, 0xf2, 0x47, 0x45, 0xa1, 0x72,
+	0x2d, 0x36, 0x49, 0x92, 0x43, 0x3d, 0x07, 0x13, 0x31, 0x99, 0xc4, 0xca, 0x15, 0x05, 0x93, 0x9c,
+	0x5a, 0x4d, 0xa5, 0x9e, 0x83, 0x89, 0x94, 0x70, 0x1a, 0x96, 0x2b, 0xca, 0x9b, 0xdb, 0xfc, 0x3f,
+	0x16, 0xdb, 0xc1, 0x7f, 0x2c, 0xb6, 0x5b, 0xf8, 0x1f, 0x0b, 0xa1, 0x84, 0x9c, 0xba, 0x46, 0x3e,
+	0x9c, 0xf3, 0xf4, 0x84, 0x0e, 0x33, 0x25, 0x84, 0xca, 0x56, 0x06, 0x1e, 0x0d, 0xb7, 0xc2, 0x4a,
+	0x38, 0x31, 0x3c, 0x53, 0x7f, 0xa7, 0x6c, 0x65, 0xe0, 0xd1, 0x70, 0x7a, 0x9e, 0x1a, 0xde, 0x3a,
+	0xcf, 0x1f, 0x9e, 0x53, 0x99, 0xd6, 0x80, 0x65, 0x23, 0x56, 0x3a, 0x25, 0x14, 0x98, 0x53, 0x6b,
+	0xa6, 0xd4, 0x73, 0x30, 0xf1, 0xad, 0x8c, 0xd5, 0x16, 0x05, 0x5b, 0x99, 0xa9, 0xa6, 0x52, 0xea,
+	0x39, 0x18, 0xc1, 0xe4, 0x73, 0xa8, 0x0e, 0x59, 0xc1, 0x91, 0xcc, 0xab, 0xe2, 0x13, 0x25, 0x4d,
+	0xca, 0x7a, 0x02, 0x16, 0x9a, 0xfd, 0xfc, 0x29, 0x2f, 0xe5, 0x99, 0xb9, 0x6b, 0x1b, 0x81, 0xda,
+	0x13, 0x05, 0x3f, 0x0d, 0x58, 0x1e, 0xc4, 0xea, 0x48, 0xe4, 0x5a, 0xfc, 0x7c, 0xc7, 0x2b, 0x2f,
+	0x94, 0x7a, 0x0e, 0x46, 0x30, 0xf9, 0x15, 0x2c, 0x0d, 0xa3, 0x82, 0x90, 0x99, 0x12, 0x70, 0xde,
+	0x79, 0xa5, 0x23, 0x4c, 0x6d, 0x51, 0x15, 0x47, 0xa8, 0xb6, 0x4c, 0xfd, 0x87, 0x52, 0xcf, 0xc1,
+	0x08, 0x26, 0xc7, 0x20, 0x0f, 0x32, 0x15, 0x10, 0xf2, 0xcd, 0x98, 0xd8, 0x39, 0xc5, 0x14, 0xca,
+	0xad, 0x99, 0xf8, 0xf0, 0x72, 0xc2, 0x7f, 0xa5, 0xc4, 0x51, 0xb3, 0x57, 0x78, 0x23, 0xd0, 0x71,
+	0x7e, 0x11, 0x04, 0x3f, 0x1f, 0xa2, 0xca, 0x20, 0x3a, 0x1f, 0xc9, 0x7a, 0x05, 0x65, 0x2b, 0x03,
+	0x8f, 0xb4, 0x64, 0xc5, 0x52, 0xf9, 0x42, 0x4b, 0x39, 0xd5, 0x04, 0x4a, 0x3d, 0x07, 0x93, 0xbe,
+	0x6c, 0x12, 0x4c, 0x72, 0xb2, 0xfb, 0x4a, 0x3d, 0x07, 0x13, 0xdd, 0x76, 0x61, 0x82, 0x58, 0xdc,
+	0x76, 0xe9, 0x54, 0xb8, 0xb2, 0x99, 0x06, 0x8b, 0xb1, 0x5f, 0xc3, 0xd2, 0x24, 0x4a, 0x2f, 0xcb,
+	0x7c, 0xb5, 0xd9, 0xa4, 0xb5, 0x52, 0xcb, 0x22, 0xa2, 0xe7, 0x62, 0x98, 0xc8, 0x15, 0xcb, 0x4a,
+	0x68, 0x59, 0x99, 0x8c, 0xb3, 0x72, 0x2d, 0x17, 0x17, 0x69, 0xc3, 0x8f, 0xe5, 0x30, 0x85, 0x36,
+	0x72, 0x72, 0xa3, 0x4a, 0x3d, 0x07, 0x13, 0x19, 0x9e, 0x91, 0xc9, 0x33, 0x0a, 0xc3, 0x9b, 0x99,
+	0xad, 0x54, 0x6e, 0xcd, 0xc4, 0xc7, 0xec, 0x39, 0x93, 0x46, 0x0c, 0xec, 0x79, 0x56, 0x32, 0x52,
+	0xb9, 0x35, 0x13, 0x2f, 0xd8, 0xee, 0xc3, 0xda, 0x30, 0x9d, 0x52, 0x9c, 0x69, 0xd0, 0x37, 0x13,
+	0xca, 0xcb, 0xa6, 0x20, 0x9f, 0x01, 0x9c, 0x85, 0x7f, 0x68, 0x11, 0x16, 0x9d, 0xf9, 0x3b, 0x8c,
+	0xb2, 0x95, 0x81, 0x47, 0xc3, 0x69, 0x98, 0x63, 0x7c, 0xcf, 0x7b, 0x93, 0x93, 0x8c, 0xfc, 0x1a,
+	0x73, 0xe3, 0x61, 0x2e, 0x50, 0x98, 0x52, 0x36, 0x8f, 0xa8, 0xd4, 0xb2, 0x88, 0x88, 0x83, 0x19,
+	0x65, 0xee, 0x04, 0x87, 0x6c, 0xd6, 0x4f, 0xa9, 0x65, 0x11, 0x82, 0xc3, 0x5f, 0xc2, 0x86, 0x9b,
+	0x93, 0x82, 0x93, 0x6f, 0x8b, 0xd3, 0x33, 0x33, 0xa5, 0xa7, 0xdc, 0xb9, 0x80, 0x42, 0x30, 0xdf,
+	0xc5, 0x44, 0x65, 0x2c, 0x91, 0x26, 0x07, 0x67, 0x32, 0x9b, 0xa6, 0x53, 0x94, 0x3c, 0x54, 0xb4,
+	0xcc, 0xb3, 0x28, 0x3d, 0x26, 0xc7, 0xf7, 0x23, 0x9e, 0x5c, 0x53, 0x6a, 0x59, 0x84, 0xe0, 0x70,
+	0x08, 0xb2, 0x9b, 0x49, 0x8e, 0xcd, 0xdc, 0xb1, 0x5b, 0xa1, 0x2c, 0x33, 0xb2, 0x69, 0xbb, 0xb0,
+	0x32, 0x89, 0x27, 0xb6, 0xc4, 0xc2, 0xf2, 0x92, 0x66, 0x8a, 0x92, 0x87, 0x8a, 0xf8, 0x98, 0xf1,
+	0xd4, 0x93, 0xe0, 0x93, 0x97, 0xee, 0x52, 0x94, 0x3c, 0x54, 0x74, 0x28, 0x68, 0x3a, 0xf9, 0xf4,
+	0x9e, 0x43, 0x31, 0x3b, 0x59, 0xa5, 0x23, 0xb3, 0x54, 0x8a, 0x48, 0x0e, 0xea, 0xea, 0xf3, 0x73,
+	0x50, 0xca, 0xcd, 0x59, 0x68, 0xc1, 0xf3, 0xb7, 0x50, 0x77, 0x67, 0xe5, 0x47, 0xe4, 0x7b, 0x42,
+	0xdd, 0x17, 0x27, 0x5e, 0x94, 0x4f, 0xde, 0x47, 0x16, 0x5e, 0x3c, 0x9b, 0xc3, 0xdc, 0x34, 0xc8,
+	0x4c, 0x8d, 0x7c, 0x1c, 0x5e, 0x13, 0x17, 0xe4, 0x4e, 0x4c, 0xd8, 0x72, 0xf3, 0xd3, 0x0f, 0xf2,
+	0xc7, 0xb1, 0xa7, 0x66, 0xa6, 0xf8, 0x77, 0x2f, 0x26, 0x12, 0xb3, 0x1c, 0xc0, 0x95, 0x61, 0x32,
+	0x38, 0x2e, 0x47, 0x2f, 0x40, 0x36, 0xa0, 0xaf, 0x5c, 0xcf, 0x47, 0x72, 0x6e, 0x3b, 0x3f, 0x54,
+	0x60, 0x99, 0x05, 0x32, 0x82, 0x2f, 0xa9, 0x06, 0x2c, 0x0f, 0x63, 0x61, 0x5b, 0x39, 0xe6, 0xd3,
+	0x24, 0x83, 0xc4, 0x4a, 0x3d, 0x07, 0x13, 0x3d, 0x9f, 0xc1, 0x15, 0xec, 0xc9, 0x57, 0x43, 0xba,
+	0x78, 0x34, 0x54, 0xd9, 0x4c, 0x83, 0xa3, 0xa3, 0x6c, 0x45, 0x51, 0x43, 0x39, 0xf4, 0x86, 0x53,
+	0x31, 0x47, 0xa5, 0x96, 0x45, 0x44, 0xcf, 0x67, 0x32, 0xb8, 0x26, 0x9e, 0xcf, 0xdc, 0x90, 0xa1,
+	0x72, 0x2d, 0x17, 0x27, 0x58, 0x1d, 0x01, 0x99, 0xa4, 0x22, 0x75, 0xf2, 0xf5, 0xe0, 0xdd, 0xce,
+	0x65, 0x77, 0x63, 0x06, 0x36, 0xda, 0xbd, 0x93, 0x64, 0xfc, 0x4d, 0xec, 0x5e, 0x7e, 0x1c, 0x4f,
+	0xb9, 0x9e, 0x8f, 0x8c, 0xfb, 0x3a, 0x51, 0x00, 0x2d, 0xf4, 0x75, 0x32, 0x81, 0x39, 0xa5, 0x9e,
+	0x83, 0xe1, 0x4c, 0xde, 0x54, 0x99, 0xad, 0x3f, 0xfa, 0xdf, 0x01, 0x00, 0xa7, 0x69, 0x60, 0x91,
+	0x81, 0x3e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PdnsServiceClient is the client API for PdnsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PdnsServiceClient interface {
+	Ping(ctx context.Context, in *Ping, opts ...grpc.CallOption) (*Pong, error)
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	InitZone(ctx context.Context, in *InitZoneRequest, opts ...grpc.CallOption) (*InitZoneResponse, error)
+	RemoveZone(ctx context.Context, in *RemoveZoneRequest, opts ...grpc.CallOption) (*RemoveZoneResponse, error)
+	AddRecord(ctx context.Context, in *AddRecordRequest, opts ...grpc.CallOption) (*AddRecordResponse, error)
+	RemoveRecord(ctx context.Context, in *RemoveRecordRequest, opts ...grpc.CallOption) (*RemoveRecordResponse, error)
+	UpdateRecord(ctx context.Context, in *UpdateRecordRequest, opts ...grpc.CallOption) (*UpdateRecordResponse, error)
+	GetDomains(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetDomainsResponse, error)
+	GetRecords(ctx context.Context, in *GetRecordsRequest, opts ...grpc.CallOption) (*GetRecordsResponse, error)
+	ImportZone(ctx context.Context, in *ImportZoneRequest, opts ...grpc.CallOption) (*ImportZoneResponse, error)
+	ExportZone(ctx context.Context, in *ExportZoneRequest, opts ...grpc.CallOption) (*ExportZoneResponse, error)
+	ApplyChanges(ctx context.Context, in *ApplyChangesRequest, opts ...grpc.CallOption) (*ApplyChangesResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	GetJWKS(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetJWKSResponse, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	GetOrganizations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetOrganizationsResponse, error)
+	GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error)
+	InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...grpc.CallOption) (*InviteMemberResponse, error)
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	ShareZone(ctx context.Context, in *ShareZoneRequest, opts ...grpc.CallOption) (*ShareZoneResponse, error)
+	UnshareZone(ctx context.Context, in *UnshareZoneRequest, opts ...grpc.CallOption) (*UnshareZoneResponse, error)
+	ListZoneShares(ctx context.Context, in *ListZoneSharesRequest, opts ...grpc.CallOption) (*ListZoneSharesResponse, error)
+	TransferZone(ctx context.Context, in *TransferZoneRequest, opts ...grpc.CallOption) (*TransferZoneResponse, error)
+	AcceptZoneTransfer(ctx context.Context, in *AcceptZoneTransferRequest, opts ...grpc.CallOption) (*AcceptZoneTransferResponse, error)
+	CancelZoneTransfer(ctx context.Context, in *CancelZoneTransferRequest, opts ...grpc.CallOption) (*CancelZoneTransferResponse, error)
+	ListZoneTransfers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListZoneTransfersResponse, error)
+	VerifyZone(ctx context.Context, in *VerifyZoneRequest, opts ...grpc.CallOption) (*VerifyZoneResponse, error)
+	EnrollTOTP(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*EnrollTOTPResponse, error)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error)
+	DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	ResendVerification(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ResendVerificationResponse, error)
+	UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	ExportAccountData(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ExportAccountDataResponse, error)
+	ExchangeOIDCToken(ctx context.Context, in *ExchangeOIDCTokenRequest, opts ...grpc.CallOption) (*ExchangeOIDCTokenResponse, error)
+	RegisterClientCertificate(ctx context.Context, in *RegisterClientCertificateRequest, opts ...grpc.CallOption) (*RegisterClientCertificateResponse, error)
+	ListClientCertificates(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListClientCertificatesResponse, error)
+	RemoveClientCertificate(ctx context.Context, in *RemoveClientCertificateRequest, opts ...grpc.CallOption) (*RemoveClientCertificateResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+}
+
+type pdnsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPdnsServiceClient(cc *grpc.ClientConn) PdnsServiceClient {
+	return &pdnsServiceClient{cc}
+}
+
+func (c *pdnsServiceClient) Ping(ctx context.Context, in *Ping, opts ...grpc.CallOption) (*Pong, error) {
+	out := new(Pong)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/createAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenResponse, error) {
+	out := new(GetTokenResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/getToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/changePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) InitZone(ctx context.Context, in *InitZoneRequest, opts ...grpc.CallOption) (*InitZoneResponse, error) {
+	out := new(InitZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/initZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) RemoveZone(ctx context.Context, in *RemoveZoneRequest, opts ...grpc.CallOption) (*RemoveZoneResponse, error) {
+	out := new(RemoveZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/removeZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) AddRecord(ctx context.Context, in *AddRecordRequest, opts ...grpc.CallOption) (*AddRecordResponse, error) {
+	out := new(AddRecordResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/addRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) RemoveRecord(ctx context.Context, in *RemoveRecordRequest, opts ...grpc.CallOption) (*RemoveRecordResponse, error) {
+	out := new(RemoveRecordResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/removeRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) UpdateRecord(ctx context.Context, in *UpdateRecordRequest, opts ...grpc.CallOption) (*UpdateRecordResponse, error) {
+	out := new(UpdateRecordResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/updateRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) GetDomains(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetDomainsResponse, error) {
+	out := new(GetDomainsResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/getDomains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) GetRecords(ctx context.Context, in *GetRecordsRequest, opts ...grpc.CallOption) (*GetRecordsResponse, error) {
+	out := new(GetRecordsResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/getRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ImportZone(ctx context.Context, in *ImportZoneRequest, opts ...grpc.CallOption) (*ImportZoneResponse, error) {
+	out := new(ImportZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/importZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ExportZone(ctx context.Context, in *ExportZoneRequest, opts ...grpc.CallOption) (*ExportZoneResponse, error) {
+	out := new(ExportZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/exportZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ApplyChanges(ctx context.Context, in *ApplyChangesRequest, opts ...grpc.CallOption) (*ApplyChangesResponse, error) {
+	out := new(ApplyChangesResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/applyChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/refreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) GetJWKS(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetJWKSResponse, error) {
+	out := new(GetJWKSResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/getJWKS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/createAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ListAPIKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/listAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/revokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
+	out := new(CreateOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/createOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) GetOrganizations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetOrganizationsResponse, error) {
+	out := new(GetOrganizationsResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/getOrganizations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error) {
+	out := new(GetMembersResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/getMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...grpc.CallOption) (*InviteMemberResponse, error) {
+	out := new(InviteMemberResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/inviteMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error) {
+	out := new(RemoveMemberResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/removeMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ShareZone(ctx context.Context, in *ShareZoneRequest, opts ...grpc.CallOption) (*ShareZoneResponse, error) {
+	out := new(ShareZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/shareZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) UnshareZone(ctx context.Context, in *UnshareZoneRequest, opts ...grpc.CallOption) (*UnshareZoneResponse, error) {
+	out := new(UnshareZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/unshareZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ListZoneShares(ctx context.Context, in *ListZoneSharesRequest, opts ...grpc.CallOption) (*ListZoneSharesResponse, error) {
+	out := new(ListZoneSharesResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/listZoneShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) TransferZone(ctx context.Context, in *TransferZoneRequest, opts ...grpc.CallOption) (*TransferZoneResponse, error) {
+	out := new(TransferZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/transferZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) AcceptZoneTransfer(ctx context.Context, in *AcceptZoneTransferRequest, opts ...grpc.CallOption) (*AcceptZoneTransferResponse, error) {
+	out := new(AcceptZoneTransferResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/acceptZoneTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) CancelZoneTransfer(ctx context.Context, in *CancelZoneTransferRequest, opts ...grpc.CallOption) (*CancelZoneTransferResponse, error) {
+	out := new(CancelZoneTransferResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/cancelZoneTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ListZoneTransfers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListZoneTransfersResponse, error) {
+	out := new(ListZoneTransfersResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/listZoneTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) VerifyZone(ctx context.Context, in *VerifyZoneRequest, opts ...grpc.CallOption) (*VerifyZoneResponse, error) {
+	out := new(VerifyZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/verifyZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) EnrollTOTP(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*EnrollTOTPResponse, error) {
+	out := new(EnrollTOTPResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/enrollTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error) {
+	out := new(ConfirmTOTPResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/confirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error) {
+	out := new(DisableTOTPResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/disableTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/requestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/resetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/verifyEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ResendVerification(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ResendVerificationResponse, error) {
+	out := new(ResendVerificationResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/resendVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error) {
+	out := new(UnlockAccountResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/unlockAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/deleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ExportAccountData(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ExportAccountDataResponse, error) {
+	out := new(ExportAccountDataResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/exportAccountData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ExchangeOIDCToken(ctx context.Context, in *ExchangeOIDCTokenRequest, opts ...grpc.CallOption) (*ExchangeOIDCTokenResponse, error) {
+	out := new(ExchangeOIDCTokenResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/exchangeOIDCToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) RegisterClientCertificate(ctx context.Context, in *RegisterClientCertificateRequest, opts ...grpc.CallOption) (*RegisterClientCertificateResponse, error) {
+	out := new(RegisterClientCertificateResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/registerClientCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ListClientCertificates(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListClientCertificatesResponse, error) {
+	out := new(ListClientCertificatesResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/listClientCertificates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) RemoveClientCertificate(ctx context.Context, in *RemoveClientCertificateRequest, opts ...grpc.CallOption) (*RemoveClientCertificateResponse, error) {
+	out := new(RemoveClientCertificateResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/removeClientCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pdnsServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/api.PdnsService/listAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PdnsServiceServer is the server API for PdnsService service.
+type PdnsServiceServer interface {
+	Ping(context.Context, *Ping) (*Pong, error)
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	GetToken(context.Context, *GetTokenRequest) (*GetTokenResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	InitZone(context.Context, *InitZoneRequest) (*InitZoneResponse, error)
+	RemoveZone(context.Context, *RemoveZoneRequest) (*RemoveZoneResponse, error)
+	AddRecord(context.Context, *AddRecordRequest) (*AddRecordResponse, error)
+	RemoveRecord(context.Context, *RemoveRecordRequest) (*RemoveRecordResponse, error)
+	UpdateRecord(context.Context, *UpdateRecordRequest) (*UpdateRecordResponse, error)
+	GetDomains(context.Context, *empty.Empty) (*GetDomainsResponse, error)
+	GetRecords(context.Context, *GetRecordsRequest) (*GetRecordsResponse, error)
+	ImportZone(context.Context, *ImportZoneRequest) (*ImportZoneResponse, error)
+	ExportZone(context.Context, *ExportZoneRequest) (*ExportZoneResponse, error)
+	ApplyChanges(context.Context, *ApplyChangesRequest) (*ApplyChangesResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	GetJWKS(context.Context, *empty.Empty) (*GetJWKSResponse, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(context.Context, *empty.Empty) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	GetOrganizations(context.Context, *empty.Empty) (*GetOrganizationsResponse, error)
+	GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error)
+	InviteMember(context.Context, *InviteMemberRequest) (*InviteMemberResponse, error)
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	ShareZone(context.Context, *ShareZoneRequest) (*ShareZoneResponse, error)
+	UnshareZone(context.Context, *UnshareZoneRequest) (*UnshareZoneResponse, error)
+	ListZoneShares(context.Context, *ListZoneSharesRequest) (*ListZoneSharesResponse, error)
+	TransferZone(context.Context, *TransferZoneRequest) (*TransferZoneResponse, error)
+	AcceptZoneTransfer(context.Context, *AcceptZoneTransferRequest) (*AcceptZoneTransferResponse, error)
+	CancelZoneTransfer(context.Context, *CancelZoneTransferRequest) (*CancelZoneTransferResponse, error)
+	ListZoneTransfers(context.Context, *empty.Empty) (*ListZoneTransfersResponse, error)
+	VerifyZone(context.Context, *VerifyZoneRequest) (*VerifyZoneResponse, error)
+	EnrollTOTP(context.Context, *empty.Empty) (*EnrollTOTPResponse, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error)
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	ResendVerification(context.Context, *empty.Empty) (*ResendVerificationResponse, error)
+	UnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	ExportAccountData(context.Context, *empty.Empty) (*ExportAccountDataResponse, error)
+	ExchangeOIDCToken(context.Context, *ExchangeOIDCTokenRequest) (*ExchangeOIDCTokenResponse, error)
+	RegisterClientCertificate(context.Context, *RegisterClientCertificateRequest) (*RegisterClientCertificateResponse, error)
+	ListClientCertificates(context.Context, *empty.Empty) (*ListClientCertificatesResponse, error)
+	RemoveClientCertificate(context.Context, *RemoveClientCertificateRequest) (*RemoveClientCertificateResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+}
+
+// UnimplementedPdnsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPdnsServiceServer struct {
+}
+
+func (*UnimplementedPdnsServiceServer) Ping(ctx context.Context, req *Ping) (*Pong, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedPdnsServiceServer) CreateAccount(ctx context.Context, req *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (*UnimplementedPdnsServiceServer) GetToken(ctx context.Context, req *GetTokenRequest) (*GetTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToken not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedPdnsServiceServer) InitZone(ctx context.Context, req *InitZoneRequest) (*InitZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitZone not implemented")
+}
+func (*UnimplementedPdnsServiceServer) RemoveZone(ctx context.Context, req *RemoveZoneRequest) (*RemoveZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveZone not implemented")
+}
+func (*UnimplementedPdnsServiceServer) AddRecord(ctx context.Context, req *AddRecordRequest) (*AddRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRecord not implemented")
+}
+func (*UnimplementedPdnsServiceServer) RemoveRecord(ctx context.Context, req *RemoveRecordRequest) (*RemoveRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRecord not implemented")
+}
+func (*UnimplementedPdnsServiceServer) UpdateRecord(ctx context.Context, req *UpdateRecordRequest) (*UpdateRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecord not implemented")
+}
+func (*UnimplementedPdnsServiceServer) GetDomains(ctx context.Context, req *empty.Empty) (*GetDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDomains not implemented")
+}
+func (*UnimplementedPdnsServiceServer) GetRecords(ctx context.Context, req *GetRecordsRequest) (*GetRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecords not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ImportZone(ctx context.Context, req *ImportZoneRequest) (*ImportZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportZone not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ExportZone(ctx context.Context, req *ExportZoneRequest) (*ExportZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportZone not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ApplyChanges(ctx context.Context, req *ApplyChangesRequest) (*ApplyChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyChanges not implemented")
+}
+func (*UnimplementedPdnsServiceServer) RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (*UnimplementedPdnsServiceServer) Logout(ctx context.Context, req *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (*UnimplementedPdnsServiceServer) GetJWKS(ctx context.Context, req *empty.Empty) (*GetJWKSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJWKS not implemented")
+}
+func (*UnimplementedPdnsServiceServer) CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ListAPIKeys(ctx context.Context, req *empty.Empty) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (*UnimplementedPdnsServiceServer) RevokeAPIKey(ctx context.Context, req *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (*UnimplementedPdnsServiceServer) CreateOrganization(ctx context.Context, req *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (*UnimplementedPdnsServiceServer) GetOrganizations(ctx context.Context, req *empty.Empty) (*GetOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizations not implemented")
+}
+func (*UnimplementedPdnsServiceServer) GetMembers(ctx context.Context, req *GetMembersRequest) (*GetMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMembers not implemented")
+}
+func (*UnimplementedPdnsServiceServer) InviteMember(ctx context.Context, req *InviteMemberRequest) (*InviteMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteMember not implemented")
+}
+func (*UnimplementedPdnsServiceServer) RemoveMember(ctx context.Context, req *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ShareZone(ctx context.Context, req *ShareZoneRequest) (*ShareZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareZone not implemented")
+}
+func (*UnimplementedPdnsServiceServer) UnshareZone(ctx context.Context, req *UnshareZoneRequest) (*UnshareZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareZone not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ListZoneShares(ctx context.Context, req *ListZoneSharesRequest) (*ListZoneSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListZoneShares not implemented")
+}
+func (*UnimplementedPdnsServiceServer) TransferZone(ctx context.Context, req *TransferZoneRequest) (*TransferZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferZone not implemented")
+}
+func (*UnimplementedPdnsServiceServer) AcceptZoneTransfer(ctx context.Context, req *AcceptZoneTransferRequest) (*AcceptZoneTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptZoneTransfer not implemented")
+}
+func (*UnimplementedPdnsServiceServer) CancelZoneTransfer(ctx context.Context, req *CancelZoneTransferRequest) (*CancelZoneTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelZoneTransfer not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ListZoneTransfers(ctx context.Context, req *empty.Empty) (*ListZoneTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListZoneTransfers not implemented")
+}
+func (*UnimplementedPdnsServiceServer) VerifyZone(ctx context.Context, req *VerifyZoneRequest) (*VerifyZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyZone not implemented")
+}
+func (*UnimplementedPdnsServiceServer) EnrollTOTP(ctx context.Context, req *empty.Empty) (*EnrollTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ConfirmTOTP(ctx context.Context, req *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (*UnimplementedPdnsServiceServer) DisableTOTP(ctx context.Context, req *DisableTOTPRequest) (*DisableTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (*UnimplementedPdnsServiceServer) RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ResetPassword(ctx context.Context, req *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (*UnimplementedPdnsServiceServer) VerifyEmail(ctx context.Context, req *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ResendVerification(ctx context.Context, req *empty.Empty) (*ResendVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendVerification not implemented")
+}
+func (*UnimplementedPdnsServiceServer) UnlockAccount(ctx context.Context, req *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockAccount not implemented")
+}
+func (*UnimplementedPdnsServiceServer) DeleteAccount(ctx context.Context, req *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ExportAccountData(ctx context.Context, req *empty.Empty) (*ExportAccountDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccountData not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ExchangeOIDCToken(ctx context.Context, req *ExchangeOIDCTokenRequest) (*ExchangeOIDCTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeOIDCToken not implemented")
+}
+func (*UnimplementedPdnsServiceServer) RegisterClientCertificate(ctx context.Context, req *RegisterClientCertificateRequest) (*RegisterClientCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterClientCertificate not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ListClientCertificates(ctx context.Context, req *empty.Empty) (*ListClientCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientCertificates not implemented")
+}
+func (*UnimplementedPdnsServiceServer) RemoveClientCertificate(ctx context.Context, req *RemoveClientCertificateRequest) (*RemoveClientCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveClientCertificate not implemented")
+}
+func (*UnimplementedPdnsServiceServer) ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+
+func RegisterPdnsServiceServer(s *grpc.Server, srv PdnsServiceServer) {
+	s.RegisterService(&_PdnsService_serviceDesc, srv)
+}
+
+func _PdnsService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Ping)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).Ping(ctx, req.(*Ping))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_GetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).GetToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/GetToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).GetToken(ctx, req.(*GetTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_InitZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).InitZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/InitZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).InitZone(ctx, req.(*InitZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_RemoveZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).RemoveZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/RemoveZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).RemoveZone(ctx, req.(*RemoveZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_AddRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).AddRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/AddRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).AddRecord(ctx, req.(*AddRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_RemoveRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).RemoveRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/RemoveRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).RemoveRecord(ctx, req.(*RemoveRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_UpdateRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).UpdateRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/UpdateRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).UpdateRecord(ctx, req.(*UpdateRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_GetDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).GetDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/GetDomains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).GetDomains(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_GetRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).GetRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/GetRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).GetRecords(ctx, req.(*GetRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ImportZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ImportZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ImportZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ImportZone(ctx, req.(*ImportZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ExportZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ExportZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ExportZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ExportZone(ctx, req.(*ExportZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ApplyChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ApplyChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ApplyChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ApplyChanges(ctx, req.(*ApplyChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).GetJWKS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/GetJWKS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).GetJWKS(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ListAPIKeys(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/CreateOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_GetOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).GetOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/GetOrganizations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).GetOrganizations(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).GetMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/GetMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).GetMembers(ctx, req.(*GetMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_InviteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).InviteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/InviteMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).InviteMember(ctx, req.(*InviteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/RemoveMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ShareZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ShareZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ShareZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ShareZone(ctx, req.(*ShareZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_UnshareZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).UnshareZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/UnshareZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).UnshareZone(ctx, req.(*UnshareZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ListZoneShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListZoneSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ListZoneShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ListZoneShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ListZoneShares(ctx, req.(*ListZoneSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_TransferZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).TransferZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/TransferZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).TransferZone(ctx, req.(*TransferZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_AcceptZoneTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptZoneTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).AcceptZoneTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/AcceptZoneTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).AcceptZoneTransfer(ctx, req.(*AcceptZoneTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_CancelZoneTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelZoneTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).CancelZoneTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/CancelZoneTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).CancelZoneTransfer(ctx, req.(*CancelZoneTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ListZoneTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ListZoneTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ListZoneTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ListZoneTransfers(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_VerifyZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).VerifyZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/VerifyZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).VerifyZone(ctx, req.(*VerifyZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/EnrollTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).EnrollTOTP(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ConfirmTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/DisableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).DisableTOTP(ctx, req.(*DisableTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/VerifyEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+func _PdnsService_ResendVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ResendVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ResendVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ResendVerification(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x6d, 0x73, 0xdb, 0xc4,
-	0x13, 0xaf, 0x1f, 0xe2, 0x24, 0x9b, 0x36, 0xdd, 0x5c, 0x1e, 0xea, 0xba, 0xfd, 0xff, 0x9b, 0x1a,
-	0x0a, 0x69, 0x53, 0x5c, 0x48, 0xd2, 0x47, 0x28, 0xed, 0x45, 0x92, 0x1d, 0x11, 0x5b, 0xd6, 0x48,
-	0x4e, 0x49, 0x79, 0xc3, 0xa8, 0xf6, 0xe1, 0x6a, 0x9a, 0x48, 0x46, 0x52, 0x4a, 0x33, 0xc3, 0x37,
-	0xe0, 0x63, 0xf0, 0x11, 0x98, 0xe1, 0x0b, 0xf1, 0x45, 0x98, 0x3d, 0x9d, 0x92, 0xd8, 0x71, 0xa1,
-	0x78, 0xe0, 0x95, 0xf6, 0xf6, 0xe1, 0xf7, 0xdb, 0xdd, 0xd3, 0xe9, 0x56, 0x30, 0xeb, 0x0d, 0xfc,
-	0xda, 0x20, 0x0a, 0x93, 0x90, 0x15, 0xbc, 0x81, 0x5f, 0xb9, 0xd6, 0x0f, 0xc3, 0xfe, 0x81, 0xb8,
-	0x27, 0x55, 0xaf, 0x8e, 0x7e, 0xb8, 0x27, 0x0e, 0x07, 0xc9, 0x71, 0xea, 0x51, 0xad, 0x40, 0xd1,
-	0xf6, 0x83, 0x3e, 0x63, 0x50, 0x4c, 0xc4, 0xbb, 0xa4, 0x9c, 0x5b, 0xcd, 0xad, 0xcd, 0x3a, 0x52,
-	0x96, 0xb6, 0xf0, 0x3d, 0xb6, 0x1d, 0x58, 0xd2, 0x22, 0xe1, 0x25, 0x82, 0x77, 0xbb, 0xe1, 0x51,
-	0x90, 0x38, 0xe2, 0xc7, 0x23, 0x11, 0x27, 0x6c, 0x09, 0xa6, 0xc4, 0xa1, 0xe7, 0x1f, 0x28, 0xe7,
-	0x74, 0xc1, 0x2a, 0x30, 0x33, 0xf0, 0xe2, 0xf8, 0xa7, 0x30, 0xea, 0x95, 0xf3, 0xd2, 0x70, 0xb2,
-	0xae, 0xfe, 0x9e, 0x83, 0xe5, 0x11, 0xa8, 0x78, 0x10, 0x06, 0xb1, 0x60, 0x8f, 0xa1, 0x14, 0x27,
-	0x5e, 0x72, 0x14, 0x4b, 0xb0, 0xf9, 0x8d, 0x9b, 0x35, 0xaa, 0x6c, 0xac, 0x6f, 0xcd, 0x95, 0x8e,
-	0x8e, 0x0a, 0xa0, 0x34, 0x92, 0xf0, 0x8d, 0x08, 0x14, 0x5b, 0xba, 0xa8, 0x36, 0xa1, 0x94, 0xfa,
-	0xb1, 0x12, 0xe4, 0xdb, 0x6f, 0xf0, 0x02, 0xbb, 0x02, 0x8b, 0x66, 0x90, 0x88, 0x28, 0xf0, 0x0e,
-	0x5c, 0x11, 0xbd, 0x15, 0x91, 0x11, 0x45, 0x61, 0x84, 0x39, 0x36, 0x0f, 0xb0, 0xed, 0xf5, 0x54,
-	0x55, 0x98, 0x67, 0x0b, 0x70, 0x89, 0x1f, 0x44, 0xc2, 0xeb, 0x1d, 0x1b, 0xef, 0xfc, 0x38, 0x89,
-	0xb1, 0x50, 0xd5, 0xe0, 0x72, 0x5f, 0x24, 0x1d, 0x42, 0x9e, 0xbc, 0xfa, 0x3d, 0xc0, 0x53, 0x10,
-	0x55, 0xf7, 0xfa, 0x48, 0xdd, 0x8b, 0xb2, 0xee, 0xcc, 0xfc, 0x41, 0x95, 0xae, 0xc3, 0x72, 0xf7,
-	0xb5, 0x17, 0xf4, 0x85, 0xad, 0x88, 0xb2, 0x0c, 0x19, 0x14, 0x89, 0x3b, 0xdb, 0x4b, 0x92, 0xab,
-	0x06, 0xac, 0x8c, 0x3a, 0x4f, 0x90, 0x49, 0xf5, 0x36, 0x5c, 0x36, 0x03, 0x3f, 0xf9, 0x2e, 0x0c,
-	0x44, 0xc6, 0xb6, 0x02, 0xa5, 0x5e, 0x78, 0xe8, 0xf9, 0x81, 0xe2, 0x53, 0xab, 0xea, 0x33, 0xc0,
-	0x53, 0xd7, 0x49, 0xb8, 0xd6, 0x61, 0xc1, 0x11, 0x87, 0xe1, 0x5b, 0xf1, 0x21, 0x6c, 0x1c, 0xd8,
-	0x59, 0xe7, 0x49, 0xf8, 0x7e, 0xc9, 0x01, 0xf2, 0x5e, 0xcf, 0x11, 0xdd, 0xe1, 0x5e, 0x06, 0xde,
-	0xa1, 0xc8, 0x7a, 0x49, 0x32, 0xe5, 0x10, 0x46, 0x7e, 0xdf, 0xcf, 0xf6, 0x43, 0xad, 0xd8, 0x0d,
-	0x28, 0x26, 0xc7, 0x03, 0x51, 0x2e, 0x48, 0xae, 0xb9, 0x94, 0xcb, 0xe9, 0x1c, 0x0f, 0x84, 0x23,
-	0x0d, 0x0c, 0xa1, 0x90, 0x24, 0x07, 0xe5, 0xe2, 0x6a, 0x6e, 0xad, 0xe0, 0x90, 0xc8, 0xca, 0x30,
-	0xdd, 0x0d, 0x83, 0x44, 0x04, 0x49, 0x79, 0x4a, 0x62, 0x65, 0xcb, 0xea, 0x73, 0x58, 0x38, 0x93,
-	0xcc, 0x24, 0xf5, 0xfc, 0x0c, 0x8b, 0x69, 0x4b, 0xfe, 0xc3, 0x8a, 0xce, 0xe4, 0x5f, 0x1c, 0xce,
-	0x5f, 0x83, 0xa5, 0x61, 0xf6, 0x49, 0x4a, 0xf8, 0x23, 0x0f, 0x8b, 0x7b, 0x83, 0x9e, 0x97, 0x8c,
-	0xd4, 0x70, 0x9a, 0x6f, 0x6e, 0x28, 0xdf, 0x87, 0x50, 0x4a, 0xbc, 0xa8, 0x2f, 0x12, 0x59, 0xc7,
-	0xdc, 0xc6, 0x0d, 0x09, 0x3e, 0x06, 0xa1, 0xd6, 0x91, 0x6e, 0x8e, 0x72, 0xa7, 0xc0, 0x38, 0x3c,
-	0x8a, 0xba, 0x69, 0xa9, 0x7f, 0x15, 0xe8, 0x4a, 0x37, 0x47, 0xb9, 0x57, 0xbe, 0x85, 0x52, 0x0a,
-	0x35, 0xb6, 0xaf, 0x59, 0xff, 0xf2, 0x1f, 0xd0, 0xbf, 0xc2, 0x50, 0xff, 0x2a, 0x3e, 0x94, 0x52,
-	0xaa, 0x7f, 0x19, 0xf8, 0xfc, 0x4b, 0x48, 0x5b, 0x35, 0x5c, 0xe9, 0x24, 0x5b, 0xf5, 0x1a, 0x58,
-	0x43, 0x24, 0xba, 0x3c, 0x8d, 0xf1, 0x64, 0x9f, 0xb9, 0x5b, 0x30, 0x9d, 0x9e, 0xe6, 0xb8, 0x9c,
-	0x5f, 0x2d, 0xac, 0xcd, 0xa9, 0xba, 0x52, 0x4c, 0x27, 0xb3, 0x55, 0xef, 0x42, 0x29, 0x55, 0xb1,
-	0x79, 0xc8, 0xfb, 0x3d, 0x89, 0x5c, 0x70, 0xf2, 0x7e, 0xef, 0xa4, 0x53, 0xf9, 0xd3, 0x4e, 0xd1,
-	0x57, 0xa4, 0x21, 0x92, 0xb4, 0xb2, 0xf8, 0x6f, 0xde, 0x1f, 0x55, 0xc4, 0x89, 0xf3, 0x84, 0x45,
-	0x44, 0x69, 0xfc, 0x50, 0x11, 0xaa, 0xb5, 0x99, 0xad, 0xea, 0x43, 0x29, 0x55, 0x4d, 0xb6, 0xbd,
-	0x6a, 0x13, 0x0b, 0x63, 0xbf, 0x24, 0xc3, 0x27, 0xf1, 0x0e, 0x87, 0xf9, 0xe1, 0x5c, 0xff, 0xf1,
-	0xcd, 0x78, 0xe7, 0xd7, 0x12, 0x94, 0x52, 0x7e, 0x36, 0x05, 0x39, 0x8e, 0x17, 0xd8, 0x0c, 0x14,
-	0x39, 0xe7, 0x1c, 0x73, 0x6c, 0x16, 0xa6, 0x78, 0xdd, 0xd5, 0xb7, 0x31, 0xcf, 0xa6, 0xa1, 0xc0,
-	0xad, 0x97, 0x58, 0x90, 0xd6, 0x4e, 0x8b, 0x63, 0x51, 0xaa, 0x5e, 0x68, 0x38, 0x25, 0x55, 0xfb,
-	0x75, 0x07, 0x4b, 0xa4, 0xd2, 0x38, 0xc7, 0x69, 0x36, 0x07, 0xd3, 0x9a, 0x6e, 0xb9, 0xbb, 0xc6,
-	0x4b, 0x9c, 0x91, 0x5a, 0xdd, 0xc5, 0x59, 0x72, 0xd4, 0x0c, 0xa7, 0x83, 0x40, 0xc8, 0x9a, 0xc5,
-	0x5b, 0x06, 0xce, 0x49, 0xd1, 0x7d, 0x69, 0x69, 0x78, 0x91, 0x44, 0x7d, 0x47, 0x33, 0x75, 0xbc,
-	0x44, 0x31, 0x7a, 0xf3, 0x05, 0xce, 0x4b, 0x9d, 0xf4, 0xbc, 0xcc, 0x00, 0x4a, 0x0a, 0x13, 0xa9,
-	0x4e, 0xdd, 0xc5, 0x05, 0xf2, 0x33, 0x4c, 0x1d, 0x19, 0xf9, 0x19, 0x7b, 0xe6, 0xd6, 0x23, 0x5c,
-	0x54, 0xe2, 0x83, 0x2d, 0x5c, 0x22, 0x73, 0xc3, 0xd4, 0x71, 0x99, 0xa8, 0x1b, 0x76, 0xdb, 0xc5,
-	0x15, 0xb2, 0xee, 0x98, 0x56, 0xbd, 0x8d, 0x57, 0xc8, 0xba, 0x63, 0xda, 0x58, 0x26, 0xab, 0xe9,
-	0xea, 0x16, 0x5e, 0x95, 0x12, 0xd5, 0x52, 0x21, 0x23, 0x51, 0x5d, 0x23, 0xaa, 0xdd, 0x7d, 0xbc,
-	0x4e, 0x8a, 0xe6, 0xe6, 0x06, 0xfe, 0x4f, 0x0a, 0x0f, 0xb6, 0xf0, 0xff, 0x52, 0x68, 0x6b, 0x78,
-	0x83, 0x5c, 0x9a, 0x36, 0xae, 0x12, 0x76, 0x8b, 0x9b, 0x4d, 0x8e, 0x37, 0x33, 0x71, 0x1b, 0xab,
-	0x64, 0x6d, 0x6d, 0xe3, 0x47, 0xf2, 0xa9, 0xe3, 0xc7, 0xf2, 0x59, 0xc7, 0x5b, 0xf2, 0xd9, 0xc0,
-	0x4f, 0xa4, 0xab, 0xcc, 0xe8, 0x53, 0xa9, 0x72, 0x70, 0x4d, 0x3e, 0xf7, 0xf1, 0x36, 0x99, 0x2c,
-	0x6e, 0x77, 0x1c, 0xbc, 0x43, 0x64, 0x96, 0xa9, 0xe3, 0x3a, 0xb5, 0xc1, 0x32, 0x5b, 0x44, 0x7c,
-	0x57, 0xda, 0x65, 0xe8, 0x67, 0x14, 0x62, 0xb9, 0x58, 0xa3, 0x0a, 0x2c, 0xd7, 0xd0, 0xf0, 0x9e,
-	0x34, 0xba, 0x86, 0xb6, 0x89, 0x9f, 0xd3, 0xae, 0x4b, 0xd1, 0xe6, 0x0e, 0x6f, 0xe1, 0x17, 0xd2,
-	0x69, 0xaf, 0xd9, 0xc4, 0x0d, 0x09, 0xbb, 0xdf, 0xc1, 0x4d, 0xa9, 0x0a, 0x03, 0x81, 0x5b, 0xe4,
-	0xdc, 0xb6, 0x0d, 0xcb, 0x6e, 0xd8, 0xd4, 0x80, 0xfb, 0xe4, 0xd2, 0xb6, 0x3b, 0xf8, 0x80, 0x04,
-	0xca, 0xe5, 0x21, 0x71, 0xd9, 0xfb, 0xf8, 0x88, 0x62, 0x1c, 0xf2, 0x79, 0x4c, 0x1a, 0xc7, 0xc6,
-	0x27, 0xc4, 0xe9, 0x38, 0xae, 0xd9, 0xc0, 0x2f, 0xa5, 0xaa, 0x83, 0x5f, 0xb1, 0x8b, 0x30, 0xe3,
-	0x88, 0x98, 0x5e, 0xc2, 0x1e, 0x3e, 0x25, 0x0c, 0x32, 0x7f, 0x4d, 0x65, 0xb8, 0x2d, 0xb3, 0x65,
-	0x70, 0x7c, 0x26, 0x95, 0x6d, 0x8e, 0xcf, 0xa5, 0x60, 0xd7, 0x91, 0x4b, 0xc1, 0x79, 0x81, 0xdb,
-	0x04, 0xe8, 0xba, 0x3b, 0x75, 0x1b, 0x35, 0x02, 0xec, 0x70, 0xd4, 0x29, 0xb2, 0xc3, 0x9b, 0xa6,
-	0xb5, 0x8b, 0x06, 0x65, 0xd0, 0xa1, 0x0c, 0xea, 0x52, 0x6a, 0xba, 0x1c, 0x1b, 0x52, 0x22, 0x8e,
-	0x1d, 0x42, 0xe9, 0xec, 0x77, 0xd0, 0x24, 0x61, 0xcf, 0xd4, 0xf1, 0x1b, 0x82, 0xdb, 0x93, 0x0d,
-	0xdb, 0x25, 0x98, 0x3d, 0xcb, 0xb5, 0x0d, 0x0d, 0x9b, 0xd2, 0xee, 0x98, 0xd8, 0x22, 0x61, 0x7f,
-	0xe3, 0x3e, 0x5a, 0x94, 0xb5, 0xe5, 0x72, 0xfb, 0x7b, 0x2a, 0xb8, 0xbd, 0xf1, 0xdb, 0x14, 0xcc,
-	0xd9, 0xbd, 0x20, 0xa6, 0xb3, 0xe4, 0x77, 0x05, 0xbb, 0x0e, 0xc5, 0x01, 0xcd, 0xdd, 0xb3, 0xf2,
-	0xfc, 0xd2, 0x08, 0x5e, 0x51, 0x22, 0x4d, 0xdc, 0x75, 0xb8, 0xd4, 0x3d, 0x3b, 0xe6, 0xb2, 0xab,
-	0xe3, 0x46, 0x5f, 0x79, 0x02, 0x2b, 0x95, 0xf7, 0x4f, 0xc5, 0xec, 0x21, 0xcc, 0x64, 0xd3, 0x25,
-	0x5b, 0x92, 0x7e, 0x23, 0x13, 0x6b, 0x65, 0x79, 0x44, 0xab, 0x02, 0x4d, 0x98, 0x1f, 0x1e, 0x09,
-	0x59, 0x4a, 0x33, 0x76, 0xa8, 0xac, 0x5c, 0x1b, 0x6b, 0x3b, 0xcd, 0xc1, 0x57, 0xb3, 0x9e, 0xca,
-	0x61, 0x64, 0x4a, 0x54, 0x39, 0x9c, 0x1b, 0x08, 0x9f, 0x02, 0x44, 0x27, 0x63, 0x1b, 0x5b, 0x51,
-	0x9f, 0xca, 0x91, 0xa1, 0xaf, 0x72, 0xe5, 0x9c, 0x5e, 0x85, 0x3f, 0x81, 0x59, 0x2f, 0x1b, 0x92,
-	0x58, 0x4a, 0x31, 0x3a, 0xc1, 0x55, 0x56, 0x46, 0xd5, 0x2a, 0x56, 0x83, 0x8b, 0xd1, 0x99, 0x01,
-	0x85, 0x95, 0xcf, 0x90, 0x0c, 0x23, 0x5c, 0x1d, 0x63, 0x39, 0x05, 0x39, 0x3a, 0x73, 0x75, 0x2a,
-	0x90, 0x31, 0x73, 0x83, 0x02, 0x19, 0x7b, 0xcf, 0x3e, 0x05, 0xe8, 0x9f, 0x5c, 0x9d, 0x6c, 0xa5,
-	0x96, 0xfe, 0xcb, 0xd5, 0xb2, 0x7f, 0xb9, 0x9a, 0x41, 0xff, 0x72, 0xaa, 0x09, 0x63, 0xee, 0xd8,
-	0x34, 0x5c, 0x5d, 0x5a, 0xaa, 0x87, 0xe7, 0xae, 0xbc, 0xd3, 0xf0, 0x91, 0xdb, 0xed, 0x55, 0x49,
-	0xf2, 0x6c, 0xfe, 0x19, 0x00, 0x00, 0xff, 0xff, 0x59, 0x0c, 0x9c, 0xb2, 0x53, 0x0e, 0x00, 0x00,
+func _PdnsService_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).UnlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/UnlockAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).UnlockAccount(ctx, req.(*UnlockAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func _PdnsService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func _PdnsService_ExportAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ExportAccountData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ExportAccountData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ExportAccountData(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ExchangeOIDCToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeOIDCTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ExchangeOIDCToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ExchangeOIDCToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ExchangeOIDCToken(ctx, req.(*ExchangeOIDCTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_RegisterClientCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterClientCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).RegisterClientCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/RegisterClientCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).RegisterClientCertificate(ctx, req.(*RegisterClientCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ListClientCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ListClientCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ListClientCertificates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ListClientCertificates(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_RemoveClientCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveClientCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).RemoveClientCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/RemoveClientCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).RemoveClientCertificate(ctx, req.(*RemoveClientCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PdnsService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PdnsServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PdnsService/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PdnsServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PdnsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.PdnsService",
+	HandlerType: (*PdnsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ping",
+			Handler:    _PdnsService_Ping_Handler,
+		},
+		{
+			MethodName: "createAccount",
+			Handler:    _PdnsService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "getToken",
+			Handler:    _PdnsService_GetToken_Handler,
+		},
+		{
+			MethodName: "changePassword",
+			Handler:    _PdnsService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "initZone",
+			Handler:    _PdnsService_InitZone_Handler,
+		},
+		{
+			MethodName: "removeZone",
+			Handler:    _PdnsService_RemoveZone_Handler,
+		},
+		{
+			MethodName: "addRecord",
+			Handler:    _PdnsService_AddRecord_Handler,
+		},
+		{
+			MethodName: "removeRecord",
+			Handler:    _PdnsService_RemoveRecord_Handler,
+		},
+		{
+			MethodName: "updateRecord",
+			Handler:    _PdnsService_UpdateRecord_Handler,
+		},
+		{
+			MethodName: "getDomains",
+			Handler:    _PdnsService_GetDomains_Handler,
+		},
+		{
+			MethodName: "getRecords",
+			Handler:    _PdnsService_GetRecords_Handler,
+		},
+		{
+			MethodName: "importZone",
+			Handler:    _PdnsService_ImportZone_Handler,
+		},
+		{
+			MethodName: "exportZone",
+			Handler:    _PdnsService_ExportZone_Handler,
+		},
+		{
+			MethodName: "applyChanges",
+			Handler:    _PdnsService_ApplyChanges_Handler,
+		},
+		{
+			MethodName: "refreshToken",
+			Handler:    _PdnsService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "logout",
+			Handler:    _PdnsService_Logout_Handler,
+		},
+		{
+			MethodName: "getJWKS",
+			Handler:    _PdnsService_GetJWKS_Handler,
+		},
+		{
+			MethodName: "createAPIKey",
+			Handler:    _PdnsService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "listAPIKeys",
+			Handler:    _PdnsService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "revokeAPIKey",
+			Handler:    _PdnsService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "createOrganization",
+			Handler:    _PdnsService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "getOrganizations",
+			Handler:    _PdnsService_GetOrganizations_Handler,
+		},
+		{
+			MethodName: "getMembers",
+			Handler:    _PdnsService_GetMembers_Handler,
+		},
+		{
+			MethodName: "inviteMember",
+			Handler:    _PdnsService_InviteMember_Handler,
+		},
+		{
+			MethodName: "removeMember",
+			Handler:    _PdnsService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "shareZone",
+			Handler:    _PdnsService_ShareZone_Handler,
+		},
+		{
+			MethodName: "unshareZone",
+			Handler:    _PdnsService_UnshareZone_Handler,
+		},
+		{
+			MethodName: "listZoneShares",
+			Handler:    _PdnsService_ListZoneShares_Handler,
+		},
+		{
+			MethodName: "transferZone",
+			Handler:    _PdnsService_TransferZone_Handler,
+		},
+		{
+			MethodName: "acceptZoneTransfer",
+			Handler:    _PdnsService_AcceptZoneTransfer_Handler,
+		},
+		{
+			MethodName: "cancelZoneTransfer",
+			Handler:    _PdnsService_CancelZoneTransfer_Handler,
+		},
+		{
+			MethodName: "listZoneTransfers",
+			Handler:    _PdnsService_ListZoneTransfers_Handler,
+		},
+		{
+			MethodName: "verifyZone",
+			Handler:    _PdnsService_VerifyZone_Handler,
+		},
+		{
+			MethodName: "enrollTOTP",
+			Handler:    _PdnsService_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "confirmTOTP",
+			Handler:    _PdnsService_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "disableTOTP",
+			Handler:    _PdnsService_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "requestPasswordReset",
+			Handler:    _PdnsService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "resetPassword",
+			Handler:    _PdnsService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "verifyEmail",
+			Handler:    _PdnsService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "resendVerification",
+			Handler:    _PdnsService_ResendVerification_Handler,
+		},
+		{
+			MethodName: "unlockAccount",
+			Handler:    _PdnsService_UnlockAccount_Handler,
+		},
+		{
+			MethodName: "deleteAccount",
+			Handler:    _PdnsService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "exportAccountData",
+			Handler:    _PdnsService_ExportAccountData_Handler,
+		},
+		{
+			MethodName: "exchangeOIDCToken",
+			Handler:    _PdnsService_ExchangeOIDCToken_Handler,
+		},
+		{
+			MethodName: "registerClientCertificate",
+			Handler:    _PdnsService_RegisterClientCertificate_Handler,
+		},
+		{
+			MethodName: "listClientCertificates",
+			Handler:    _PdnsService_ListClientCertificates_Handler,
+		},
+		{
+			MethodName: "removeClientCertificate",
+			Handler:    _PdnsService_RemoveClientCertificate_Handler,
+		},
+		{
+			MethodName: "listAuditEvents",
+			Handler:    _PdnsService_ListAuditEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api.proto",
+}
 
-// PdnsServiceClient is the client API for PdnsService service.
+// AdminServiceClient is the client API for AdminService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PdnsServiceClient interface {
-	Ping(ctx context.Context, in *Ping, opts ...grpc.CallOption) (*Pong, error)
-	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
-	GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenResponse, error)
-	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
-	InitZone(ctx context.Context, in *InitZoneRequest, opts ...grpc.CallOption) (*InitZoneResponse, error)
-	RemoveZone(ctx context.Context, in *RemoveZoneRequest, opts ...grpc.CallOption) (*RemoveZoneResponse, error)
-	AddRecord(ctx context.Context, in *AddRecordRequest, opts ...grpc.CallOption) (*AddRecordResponse, error)
-	RemoveRecord(ctx context.Context, in *RemoveRecordRequest, opts ...grpc.CallOption) (*RemoveRecordResponse, error)
-	UpdateRecord(ctx context.Context, in *UpdateRecordRequest, opts ...grpc.CallOption) (*UpdateRecordResponse, error)
-	GetDomains(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetDomainsResponse, error)
-	GetRecords(ctx context.Context, in *GetRecordsRequest, opts ...grpc.CallOption) (*GetRecordsResponse, error)
+type AdminServiceClient interface {
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	ListZones(ctx context.Context, in *ListZonesRequest, opts ...grpc.CallOption) (*ListZonesResponse, error)
+	Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*ImpersonateResponse, error)
+	SuspendAccount(ctx context.Context, in *SuspendAccountRequest, opts ...grpc.CallOption) (*SuspendAccountResponse, error)
+	UnsuspendAccount(ctx context.Context, in *UnsuspendAccountRequest, opts ...grpc.CallOption) (*UnsuspendAccountResponse, error)
+	ForceRemoveZone(ctx context.Context, in *ForceRemoveZoneRequest, opts ...grpc.CallOption) (*ForceRemoveZoneResponse, error)
+	ReassignZone(ctx context.Context, in *ReassignZoneRequest, opts ...grpc.CallOption) (*ReassignZoneResponse, error)
 }
 
-type pdnsServiceClient struct {
+type adminServiceClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewPdnsServiceClient(cc *grpc.ClientConn) PdnsServiceClient {
-	return &pdnsServiceClient{cc}
-}
-
-func (c *pdnsServiceClient) Ping(ctx context.Context, in *Ping, opts ...grpc.CallOption) (*Pong, error) {
-	out := new(Pong)
-	err := c.cc.Invoke(ctx, "/api.PdnsService/ping", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *pdnsServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
-	out := new(CreateAccountResponse)
-	err := c.cc.Invoke(ctx, "/api.PdnsService/createAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *pdnsServiceClient) GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenResponse, error) {
-	out := new(GetTokenResponse)
-	err := c.cc.Invoke(ctx, "/api.PdnsService/getToken", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *pdnsServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
-	out := new(ChangePasswordResponse)
-	err := c.cc.Invoke(ctx, "/api.PdnsService/changePassword", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
+	return &adminServiceClient{cc}
 }
 
-func (c *pdnsServiceClient) InitZone(ctx context.Context, in *InitZoneRequest, opts ...grpc.CallOption) (*InitZoneResponse, error) {
-	out := new(InitZoneResponse)
-	err := c.cc.Invoke(ctx, "/api.PdnsService/initZone", in, out, opts...)
+func (c *adminServiceClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/api.AdminService/listAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pdnsServiceClient) RemoveZone(ctx context.Context, in *RemoveZoneRequest, opts ...grpc.CallOption) (*RemoveZoneResponse, error) {
-	out := new(RemoveZoneResponse)
-	err := c.cc.Invoke(ctx, "/api.PdnsService/removeZone", in, out, opts...)
+func (c *adminServiceClient) ListZones(ctx context.Context, in *ListZonesRequest, opts ...grpc.CallOption) (*ListZonesResponse, error) {
+	out := new(ListZonesResponse)
+	err := c.cc.Invoke(ctx, "/api.AdminService/listZones", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pdnsServiceClient) AddRecord(ctx context.Context, in *AddRecordRequest, opts ...grpc.CallOption) (*AddRecordResponse, error) {
-	out := new(AddRecordResponse)
-	err := c.cc.Invoke(ctx, "/api.PdnsService/addRecord", in, out, opts...)
+func (c *adminServiceClient) Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*ImpersonateResponse, error) {
+	out := new(ImpersonateResponse)
+	err := c.cc.Invoke(ctx, "/api.AdminService/impersonate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pdnsServiceClient) RemoveRecord(ctx context.Context, in *RemoveRecordRequest, opts ...grpc.CallOption) (*RemoveRecordResponse, error) {
-	out := new(RemoveRecordResponse)
-	err := c.cc.Invoke(ctx, "/api.PdnsService/removeRecord", in, out, opts...)
+func (c *adminServiceClient) SuspendAccount(ctx context.Context, in *SuspendAccountRequest, opts ...grpc.CallOption) (*SuspendAccountResponse, error) {
+	out := new(SuspendAccountResponse)
+	err := c.cc.Invoke(ctx, "/api.AdminService/suspendAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pdnsServiceClient) UpdateRecord(ctx context.Context, in *UpdateRecordRequest, opts ...grpc.CallOption) (*UpdateRecordResponse, error) {
-	out := new(UpdateRecordResponse)
-	err := c.cc.Invoke(ctx, "/api.PdnsService/updateRecord", in, out, opts...)
+func (c *adminServiceClient) UnsuspendAccount(ctx context.Context, in *UnsuspendAccountRequest, opts ...grpc.CallOption) (*UnsuspendAccountResponse, error) {
+	out := new(UnsuspendAccountResponse)
+	err := c.cc.Invoke(ctx, "/api.AdminService/unsuspendAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pdnsServiceClient) GetDomains(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetDomainsResponse, error) {
-	out := new(GetDomainsResponse)
-	err := c.cc.Invoke(ctx, "/api.PdnsService/getDomains", in, out, opts...)
+func (c *adminServiceClient) ForceRemoveZone(ctx context.Context, in *ForceRemoveZoneRequest, opts ...grpc.CallOption) (*ForceRemoveZoneResponse, error) {
+	out := new(ForceRemoveZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.AdminService/forceRemoveZone", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pdnsServiceClient) GetRecords(ctx context.Context, in *GetRecordsRequest, opts ...grpc.CallOption) (*GetRecordsResponse, error) {
-	out := new(GetRecordsResponse)
-	err := c.cc.Invoke(ctx, "/api.PdnsService/getRecords", in, out, opts...)
+func (c *adminServiceClient) ReassignZone(ctx context.Context, in *ReassignZoneRequest, opts ...grpc.CallOption) (*ReassignZoneResponse, error) {
+	out := new(ReassignZoneResponse)
+	err := c.cc.Invoke(ctx, "/api.AdminService/reassignZone", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// PdnsServiceServer is the server API for PdnsService service.
-type PdnsServiceServer interface {
-	Ping(context.Context, *Ping) (*Pong, error)
-	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
-	GetToken(context.Context, *GetTokenRequest) (*GetTokenResponse, error)
-	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
-	InitZone(context.Context, *InitZoneRequest) (*InitZoneResponse, error)
-	RemoveZone(context.Context, *RemoveZoneRequest) (*RemoveZoneResponse, error)
-	AddRecord(context.Context, *AddRecordRequest) (*AddRecordResponse, error)
-	RemoveRecord(context.Context, *RemoveRecordRequest) (*RemoveRecordResponse, error)
-	UpdateRecord(context.Context, *UpdateRecordRequest) (*UpdateRecordResponse, error)
-	GetDomains(context.Context, *empty.Empty) (*GetDomainsResponse, error)
-	GetRecords(context.Context, *GetRecordsRequest) (*GetRecordsResponse, error)
+// AdminServiceServer is the server API for AdminService service.
+type AdminServiceServer interface {
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	ListZones(context.Context, *ListZonesRequest) (*ListZonesResponse, error)
+	Impersonate(context.Context, *ImpersonateRequest) (*ImpersonateResponse, error)
+	SuspendAccount(context.Context, *SuspendAccountRequest) (*SuspendAccountResponse, error)
+	UnsuspendAccount(context.Context, *UnsuspendAccountRequest) (*UnsuspendAccountResponse, error)
+	ForceRemoveZone(context.Context, *ForceRemoveZoneRequest) (*ForceRemoveZoneResponse, error)
+	ReassignZone(context.Context, *ReassignZoneRequest) (*ReassignZoneResponse, error)
 }
 
-// UnimplementedPdnsServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedPdnsServiceServer struct {
+// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
 }
 
-func (*UnimplementedPdnsServiceServer) Ping(ctx context.Context, req *Ping) (*Pong, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
-}
-func (*UnimplementedPdnsServiceServer) CreateAccount(ctx context.Context, req *CreateAccountRequest) (*CreateAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
-}
-func (*UnimplementedPdnsServiceServer) GetToken(ctx context.Context, req *GetTokenRequest) (*GetTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetToken not implemented")
+func (*UnimplementedAdminServiceServer) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
 }
-func (*UnimplementedPdnsServiceServer) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
-}
-func (*UnimplementedPdnsServiceServer) InitZone(ctx context.Context, req *InitZoneRequest) (*InitZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitZone not implemented")
-}
-func (*UnimplementedPdnsServiceServer) RemoveZone(ctx context.Context, req *RemoveZoneRequest) (*RemoveZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveZone not implemented")
-}
-func (*UnimplementedPdnsServiceServer) AddRecord(ctx context.Context, req *AddRecordRequest) (*AddRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddRecord not implemented")
-}
-func (*UnimplementedPdnsServiceServer) RemoveRecord(ctx context.Context, req *RemoveRecordRequest) (*RemoveRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRecord not implemented")
-}
-func (*UnimplementedPdnsServiceServer) UpdateRecord(ctx context.Context, req *UpdateRecordRequest) (*UpdateRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecord not implemented")
-}
-func (*UnimplementedPdnsServiceServer) GetDomains(ctx context.Context, req *empty.Empty) (*GetDomainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDomains not implemented")
+func (*UnimplementedAdminServiceServer) ListZones(ctx context.Context, req *ListZonesRequest) (*ListZonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListZones not implemented")
 }
-func (*UnimplementedPdnsServiceServer) GetRecords(ctx context.Context, req *GetRecordsRequest) (*GetRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecords not implemented")
+func (*UnimplementedAdminServiceServer) Impersonate(ctx context.Context, req *ImpersonateRequest) (*ImpersonateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Impersonate not implemented")
 }
-
-func RegisterPdnsServiceServer(s *grpc.Server, srv PdnsServiceServer) {
-	s.RegisterService(&_PdnsService_serviceDesc, srv)
+func (*UnimplementedAdminServiceServer) SuspendAccount(ctx context.Context, req *SuspendAccountRequest) (*SuspendAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendAccount not implemented")
 }
-
-func _PdnsService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Ping)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PdnsServiceServer).Ping(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.PdnsService/Ping",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PdnsServiceServer).Ping(ctx, req.(*Ping))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedAdminServiceServer) UnsuspendAccount(ctx context.Context, req *UnsuspendAccountRequest) (*UnsuspendAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendAccount not implemented")
 }
-
-func _PdnsService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PdnsServiceServer).CreateAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.PdnsService/CreateAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PdnsServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedAdminServiceServer) ForceRemoveZone(ctx context.Context, req *ForceRemoveZoneRequest) (*ForceRemoveZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceRemoveZone not implemented")
 }
-
-func _PdnsService_GetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PdnsServiceServer).GetToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.PdnsService/GetToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PdnsServiceServer).GetToken(ctx, req.(*GetTokenRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedAdminServiceServer) ReassignZone(ctx context.Context, req *ReassignZoneRequest) (*ReassignZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignZone not implemented")
 }
 
-func _PdnsService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PdnsServiceServer).ChangePassword(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.PdnsService/ChangePassword",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PdnsServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
 }
 
-func _PdnsService_InitZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InitZoneRequest)
+func _AdminService_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PdnsServiceServer).InitZone(ctx, in)
+		return srv.(AdminServiceServer).ListAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.PdnsService/InitZone",
+		FullMethod: "/api.AdminService/ListAccounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PdnsServiceServer).InitZone(ctx, req.(*InitZoneRequest))
+		return srv.(AdminServiceServer).ListAccounts(ctx, req.(*ListAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PdnsService_RemoveZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveZoneRequest)
+func _AdminService_ListZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListZonesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PdnsServiceServer).RemoveZone(ctx, in)
+		return srv.(AdminServiceServer).ListZones(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.PdnsService/RemoveZone",
+		FullMethod: "/api.AdminService/ListZones",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PdnsServiceServer).RemoveZone(ctx, req.(*RemoveZoneRequest))
+		return srv.(AdminServiceServer).ListZones(ctx, req.(*ListZonesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PdnsService_AddRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddRecordRequest)
+func _AdminService_Impersonate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PdnsServiceServer).AddRecord(ctx, in)
+		return srv.(AdminServiceServer).Impersonate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.PdnsService/AddRecord",
+		FullMethod: "/api.AdminService/Impersonate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PdnsServiceServer).AddRecord(ctx, req.(*AddRecordRequest))
+		return srv.(AdminServiceServer).Impersonate(ctx, req.(*ImpersonateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PdnsService_RemoveRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRecordRequest)
+func _AdminService_SuspendAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PdnsServiceServer).RemoveRecord(ctx, in)
+		return srv.(AdminServiceServer).SuspendAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.PdnsService/RemoveRecord",
+		FullMethod: "/api.AdminService/SuspendAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PdnsServiceServer).RemoveRecord(ctx, req.(*RemoveRecordRequest))
+		return srv.(AdminServiceServer).SuspendAccount(ctx, req.(*SuspendAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PdnsService_UpdateRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRecordRequest)
+func _AdminService_UnsuspendAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsuspendAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PdnsServiceServer).UpdateRecord(ctx, in)
+		return srv.(AdminServiceServer).UnsuspendAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.PdnsService/UpdateRecord",
+		FullMethod: "/api.AdminService/UnsuspendAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PdnsServiceServer).UpdateRecord(ctx, req.(*UpdateRecordRequest))
+		return srv.(AdminServiceServer).UnsuspendAccount(ctx, req.(*UnsuspendAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PdnsService_GetDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _AdminService_ForceRemoveZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceRemoveZoneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PdnsServiceServer).GetDomains(ctx, in)
+		return srv.(AdminServiceServer).ForceRemoveZone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.PdnsService/GetDomains",
+		FullMethod: "/api.AdminService/ForceRemoveZone",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PdnsServiceServer).GetDomains(ctx, req.(*empty.Empty))
+		return srv.(AdminServiceServer).ForceRemoveZone(ctx, req.(*ForceRemoveZoneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PdnsService_GetRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRecordsRequest)
+func _AdminService_ReassignZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignZoneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PdnsServiceServer).GetRecords(ctx, in)
+		return srv.(AdminServiceServer).ReassignZone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.PdnsService/GetRecords",
+		FullMethod: "/api.AdminService/ReassignZone",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PdnsServiceServer).GetRecords(ctx, req.(*GetRecordsRequest))
+		return srv.(AdminServiceServer).ReassignZone(ctx, req.(*ReassignZoneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _PdnsService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.PdnsService",
-	HandlerType: (*PdnsServiceServer)(nil),
+var _AdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "ping",
-			Handler:    _PdnsService_Ping_Handler,
-		},
-		{
-			MethodName: "createAccount",
-			Handler:    _PdnsService_CreateAccount_Handler,
-		},
-		{
-			MethodName: "getToken",
-			Handler:    _PdnsService_GetToken_Handler,
-		},
-		{
-			MethodName: "changePassword",
-			Handler:    _PdnsService_ChangePassword_Handler,
-		},
-		{
-			MethodName: "initZone",
-			Handler:    _PdnsService_InitZone_Handler,
+			MethodName: "listAccounts",
+			Handler:    _AdminService_ListAccounts_Handler,
 		},
 		{
-			MethodName: "removeZone",
-			Handler:    _PdnsService_RemoveZone_Handler,
+			MethodName: "listZones",
+			Handler:    _AdminService_ListZones_Handler,
 		},
 		{
-			MethodName: "addRecord",
-			Handler:    _PdnsService_AddRecord_Handler,
+			MethodName: "impersonate",
+			Handler:    _AdminService_Impersonate_Handler,
 		},
 		{
-			MethodName: "removeRecord",
-			Handler:    _PdnsService_RemoveRecord_Handler,
+			MethodName: "suspendAccount",
+			Handler:    _AdminService_SuspendAccount_Handler,
 		},
 		{
-			MethodName: "updateRecord",
-			Handler:    _PdnsService_UpdateRecord_Handler,
+			MethodName: "unsuspendAccount",
+			Handler:    _AdminService_UnsuspendAccount_Handler,
 		},
 		{
-			MethodName: "getDomains",
-			Handler:    _PdnsService_GetDomains_Handler,
+			MethodName: "forceRemoveZone",
+			Handler:    _AdminService_ForceRemoveZone_Handler,
 		},
 		{
-			MethodName: "getRecords",
-			Handler:    _PdnsService_GetRecords_Handler,
+			MethodName: "reassignZone",
+			Handler:    _AdminService_ReassignZone_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
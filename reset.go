@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const resetBody = `A password reset was requested for your account.
+
+Reset token: %s
+
+The token expires at %s. If you did not request a reset, ignore this mail.
+`
+
+const (
+	attemptResetEmail = "reset"
+	attemptResetPeer  = "resetip"
+
+	// resetFreeRequests is how many resets of an email can be requested
+	// before further requests are delayed like failed logins.
+	resetFreeRequests = 3
+)
+
+// sendReset mails a reset token. It is called in the background, so the
+// time to deliver the mail does not tell whether the account exists.
+func sendReset(email string, token string, expires time.Time) {
+	err := GetMailer().Send(email, "Password reset", fmt.Sprintf(resetBody, token, expires.UTC().Format(time.RFC1123)))
+	if err != nil {
+		logger.Error("failed to send reset mail", zap.Error(err))
+	}
+}
+
+// RequestPasswordReset mails a reset token if the account exists. The
+// response is the same either way, so it cannot be used to find accounts.
+// Requests are counted per email and per peer address, whether the
+// account exists or not.
+func (s *server) RequestPasswordReset(ctx context.Context, in *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	email := in.GetEmail()
+	if email == "" {
+		return nil, badRequest("email", errors.New("email is required"))
+	}
+	addr := peerAddr(ctx)
+	d, err := attemptsLocked(ctx, attemptResetEmail, email, attemptResetPeer, addr)
+	if err != nil {
+		return nil, err
+	}
+	if d > 0 {
+		return nil, withDetails(status.New(codes.ResourceExhausted, "too many reset requests, try again later"),
+			&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(d)})
+	}
+	if err := recordFailure(ctx, attemptResetEmail, email, resetFreeRequests); err != nil {
+		return nil, err
+	}
+	if addr != "" {
+		if err := recordFailure(ctx, attemptResetPeer, addr, resetFreeRequests*peerAttemptFactor); err != nil {
+			return nil, err
+		}
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	var id string
+	err = tx.QueryRowContext(ctx, "SELECT id FROM accounts WHERE email = $1;", email).Scan(&id)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return &pb.RequestPasswordResetResponse{Status: pb.ResponseStatus_Ok}, nil
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	// Only the latest token is valid.
+	_, err = tx.ExecContext(ctx, "UPDATE password_resets SET used = true WHERE account = $1 AND NOT used;", id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	token, err := randomString(32)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	expires := time.Now().Add(resetTokenTTL)
+	_, err = tx.ExecContext(ctx, "INSERT INTO password_resets(account,token_hash,expires_at) VALUES ($1,$2,$3);", id, hashToken(token), expires)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	go sendReset(email, token, expires)
+	return &pb.RequestPasswordResetResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
+// ResetPassword sets a new password with a reset token and signs out
+// every session. Two-factor authentication stays enabled.
+func (s *server) ResetPassword(ctx context.Context, in *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	if in.GetToken() == "" {
+		return nil, badRequest("token", errors.New("token is required"))
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	var id, account string
+	err = tx.QueryRowContext(ctx, "SELECT id, account FROM password_resets WHERE token_hash = $1 AND NOT used AND expires_at > now();", hashToken(in.GetToken())).Scan(&id, &account)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return nil, unauthenticated("reset token is invalid")
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var email string
+	err = tx.QueryRowContext(ctx, "SELECT email FROM accounts WHERE id = $1;", account).Scan(&email)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	setAuditEmail(ctx, email)
+	if err := validatePassword(in.GetPassword(), email); err != nil {
+		tx.Rollback()
+		return nil, badRequest("password", err)
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE password_resets SET used = true WHERE id = $1;", id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE accounts SET password = crypt($1, gen_salt('bf')) WHERE id = $2;", in.GetPassword(), account)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = revokeTokens(ctx, tx, account)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResetPasswordResponse{Status: pb.ResponseStatus_Ok}, nil
+}
@@ -14,6 +14,8 @@ import (
 	pb "github.com/KoyamaSohei/special-seminar-api/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	_ "github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type server struct{}
@@ -23,8 +25,9 @@ const (
 )
 
 var (
-	mname string = os.Getenv("SOA_MNAME")
-	rname string = os.Getenv("SOA_RNAME")
+	mname  string = os.Getenv("SOA_MNAME")
+	rname  string = os.Getenv("SOA_RNAME")
+	target string = os.Getenv("TARGET_IP")
 )
 
 func genSerial() int {
@@ -34,19 +37,13 @@ func genSerial() int {
 func getAccountID(ctx context.Context, tx *sql.Tx) (string, error) {
 	info, err := getInfo(ctx)
 	if err != nil {
-		return "", err
+		return "", unauthenticated(err.Error())
 	}
 	var id string
 	err = tx.QueryRowContext(ctx, "SELECT id FROM accounts WHERE email = $1;", info.Subject).Scan(&id)
-	if err != nil {
-		return "", err
+	if err == sql.ErrNoRows {
+		return "", unauthenticated("account not found")
 	}
-	return id, nil
-}
-
-func getDomainID(ctx context.Context, tx *sql.Tx, name string, account string) (string, error) {
-	var id string
-	err := tx.QueryRowContext(ctx, "SELECT id FROM domains WHERE name = $1 AND account = $2;", name, account).Scan(&id)
 	if err != nil {
 		return "", err
 	}
@@ -54,7 +51,10 @@ func getDomainID(ctx context.Context, tx *sql.Tx, name string, account string) (
 }
 
 func updateSoa(ctx context.Context, tx *sql.Tx, origin string, account string) error {
-	id, err := getDomainID(ctx, tx, origin, account)
+	id, err := authorizeDomain(ctx, tx, origin, account, pb.Role_Editor)
+	if err != nil {
+		return err
+	}
 	var c string
 	err = tx.QueryRowContext(ctx, "SELECT content FROM records WHERE type = 'SOA' AND domain_id = $1;", id).Scan(&c)
 	if err != nil {
@@ -89,148 +89,264 @@ func (s *server) Ping(ctx context.Context, in *pb.Ping) (*pb.Pong, error) {
 func (s *server) CreateAccount(ctx context.Context, in *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
 	email := in.GetEmail()
 	pass := in.GetPassword()
-	if email == "" || pass == "" {
-		return &pb.CreateAccountResponse{Status: pb.CreateAccountResponse_BadRequest}, nil
+	if err := validateEmail(email); err != nil {
+		return nil, badRequest("email", err)
+	}
+	if err := validatePassword(pass, email); err != nil {
+		return nil, badRequest("password", err)
 	}
 	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
-		return &pb.CreateAccountResponse{Status: pb.CreateAccountResponse_InternalServerError}, err
+		return nil, err
 	}
 
 	var id string
 	err = tx.QueryRowContext(ctx, "SELECT id FROM accounts WHERE email = $1;", email).Scan(&id)
 	if err == nil {
-		_ = tx.Rollback()
-		return &pb.CreateAccountResponse{Status: pb.CreateAccountResponse_AlreadyExists}, nil
+		tx.Rollback()
+		return nil, alreadyExists("account", email, "this email is already registered")
+	}
+	if err != sql.ErrNoRows {
+		tx.Rollback()
+		return nil, err
 	}
 
-	_, err = tx.ExecContext(ctx, "INSERT INTO accounts(email,password) VALUES ($1,crypt($2, gen_salt('bf')));", email, pass)
+	err = tx.QueryRowContext(ctx, "INSERT INTO accounts(email,password) VALUES ($1,crypt($2, gen_salt('bf'))) RETURNING id;", email, pass).Scan(&id)
 	if err != nil {
-		_ = tx.Rollback()
-		return &pb.CreateAccountResponse{Status: pb.CreateAccountResponse_InternalServerError}, err
+		tx.Rollback()
+		return nil, err
 	}
-	err = tx.Commit()
+	token, refresh, err := issueTokens(ctx, tx, id, email)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	verification, expires, err := newVerification(ctx, tx, id)
 	if err != nil {
-		return &pb.CreateAccountResponse{Status: pb.CreateAccountResponse_InternalServerError}, err
+		tx.Rollback()
+		return nil, err
 	}
-	token, err := authInstance.GenerateJWTToken(email)
+	err = tx.Commit()
 	if err != nil {
-		return &pb.CreateAccountResponse{Status: pb.CreateAccountResponse_InternalServerError}, err
+		return nil, err
 	}
-	return &pb.CreateAccountResponse{Status: pb.CreateAccountResponse_Ok, Token: token}, nil
+	sendVerification(email, verification, expires)
+	return &pb.CreateAccountResponse{Status: pb.CreateAccountResponse_Ok, Token: token, RefreshToken: refresh}, nil
 }
 
 func (s *server) GetToken(ctx context.Context, in *pb.GetTokenRequest) (*pb.GetTokenResponse, error) {
 	email := in.GetEmail()
 	pass := in.GetPassword()
 	if email == "" || pass == "" {
-		return &pb.GetTokenResponse{Status: pb.ResponseStatus_BadRequest}, nil
+		return nil, badRequest("email", errors.New("email and password are required"))
+	}
+	addr := peerAddr(ctx)
+	d, err := loginLocked(ctx, email, addr)
+	if err != nil {
+		return nil, err
+	}
+	if d > 0 {
+		return nil, lockedOut(d)
 	}
 	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
-		return &pb.GetTokenResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
+	var id string
 	var valid bool
-	err = tx.QueryRowContext(ctx, "SELECT (password = crypt($1,password)) AS matched FROM accounts WHERE email = $2;", pass, email).Scan(&valid)
+	err = tx.QueryRowContext(ctx, "SELECT id, COALESCE(password = crypt($1,password), false) AS matched FROM accounts WHERE email = $2;", pass, email).Scan(&id, &valid)
+	if err == sql.ErrNoRows {
+		// Hash anyway, so unknown emails take as long as wrong passwords.
+		err = tx.QueryRowContext(ctx, "SELECT false FROM crypt($1, gen_salt('bf'));", pass).Scan(&valid)
+	}
 	if err != nil {
-		_ = tx.Rollback()
-		return &pb.GetTokenResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		tx.Rollback()
+		return nil, err
 	}
 	if !valid {
-		_ = tx.Rollback()
-		return &pb.GetTokenResponse{Status: pb.ResponseStatus_BadRequest}, err
+		tx.Rollback()
+		loginFailed(ctx, email, addr)
+		return nil, invalidLogin()
 	}
-	err = tx.Commit()
+	err = verifyLogin(ctx, tx, id, in.GetOtp())
+	if err != nil {
+		tx.Rollback()
+		if in.GetOtp() != "" && status.Code(err) == codes.Unauthenticated {
+			loginFailed(ctx, email, addr)
+		}
+		return nil, err
+	}
+	err = loginSucceeded(ctx, tx, email)
 	if err != nil {
-		_ = tx.Rollback()
-		return &pb.GetTokenResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		tx.Rollback()
+		return nil, err
+	}
+	token, refresh, err := issueTokens(ctx, tx, id, email)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
 	}
-	token, err := authInstance.GenerateJWTToken(email)
+	err = tx.Commit()
 	if err != nil {
-		_ = tx.Rollback()
-		return &pb.GetTokenResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
-	return &pb.GetTokenResponse{Status: pb.ResponseStatus_Ok, Token: token}, nil
+	return &pb.GetTokenResponse{Status: pb.ResponseStatus_Ok, Token: token, RefreshToken: refresh}, nil
 }
 
 func (s *server) ChangePassword(ctx context.Context, in *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
 	pass := in.GetPass()
-	if pass == "" {
-		return &pb.ChangePasswordResponse{Status: pb.ResponseStatus_BadRequest}, nil
+	if in.GetCurrent() == "" {
+		return nil, badRequest("current", errors.New("current password is required"))
+	}
+	info, err := getInfo(ctx)
+	if err != nil {
+		return nil, unauthenticated(err.Error())
+	}
+	if err := validatePassword(pass, info.Subject); err != nil {
+		return nil, badRequest("pass", err)
 	}
 	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
-		return &pb.ChangePasswordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	a, err := getAccountID(ctx, tx)
 	if err != nil {
-		_ = tx.Rollback()
-		return &pb.ChangePasswordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		tx.Rollback()
+		return nil, err
+	}
+	valid, err := checkPassword(ctx, tx, a, in.GetCurrent())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if !valid {
+		tx.Rollback()
+		loginFailed(ctx, info.Subject, peerAddr(ctx))
+		return nil, unauthenticated("current password is incorrect")
 	}
 	_, err = tx.ExecContext(ctx, "UPDATE accounts SET password = crypt($1, gen_salt('bf')) WHERE id = $2;", pass, a)
 	if err != nil {
 		tx.Rollback()
-		return &pb.ChangePasswordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
-	err = tx.Commit()
+	err = revokeTokens(ctx, tx, a)
 	if err != nil {
 		tx.Rollback()
-		return &pb.ChangePasswordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
-	return &pb.ChangePasswordResponse{Status: pb.ResponseStatus_Ok}, nil
-}
-
-func (s *server) InitZone(ctx context.Context, in *pb.InitZoneRequest) (*pb.InitZoneResponse, error) {
-	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	token, refresh, err := issueTokens(ctx, tx, a, info.Subject)
 	if err != nil {
-		return &pb.InitZoneResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		tx.Rollback()
+		return nil, err
 	}
-	a, err := getAccountID(ctx, tx)
+	err = tx.Commit()
 	if err != nil {
-		return &pb.InitZoneResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		tx.Rollback()
+		return nil, err
 	}
-	id, err := getDomainID(ctx, tx, in.GetDomain(), a)
+	return &pb.ChangePasswordResponse{Status: pb.ResponseStatus_Ok, Token: token, RefreshToken: refresh}, nil
+}
 
-	if err == nil {
+// initZone creates the zone owned by account, or by org if it is not 0,
+// or resets it if the account may manage it already.
+func initZone(ctx context.Context, tx *sql.Tx, domain string, account string, org int64) (string, error) {
+	id, err := authorizeDomain(ctx, tx, domain, account, pb.Role_Admin)
+
+	switch status.Code(err) {
+	case codes.OK:
 		_, err = tx.ExecContext(ctx, "DELETE FROM records WHERE domain_id = $1;", id)
-		if err != nil {
-			tx.Rollback()
-			return &pb.InitZoneResponse{Status: pb.ResponseStatus_InternalServerError}, err
+	case codes.PermissionDenied:
+		if _, e := authorizeDomain(ctx, tx, domain, account, pb.Role_Viewer); e == nil {
+			return "", err
 		}
-	} else {
-		var i string
-		err = tx.QueryRowContext(ctx, "SELECT id FROM domains WHERE name = $1;", in.GetDomain()).Scan(&i)
-		if err == nil {
-			tx.Rollback()
-			return &pb.InitZoneResponse{Status: pb.ResponseStatus_InternalServerError}, fmt.Errorf("this domain is already used by other user")
+		return "", alreadyExists("domain", domain, "this domain is already used by other user")
+	case codes.NotFound:
+		if err = checkZoneLimit(ctx, tx, account); err != nil {
+			return "", err
 		}
-		_, err = tx.ExecContext(ctx, "INSERT INTO domains(name,type,account) VALUES ($1,'master',$2);", in.GetDomain(), a)
+		if requireVerification {
+			return "", failedPrecondition("domain", "the domain must be verified before the zone is created")
+		}
+		return createZone(ctx, tx, domain, account, org)
 	}
 
 	if err != nil {
-		tx.Rollback()
-		return &pb.InitZoneResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return "", err
+	}
+	return id, addDefaultRecords(ctx, tx, id, domain)
+}
+
+// createZone creates the zone owned by account, or by org if it is not 0.
+// The caller checks that the domain is free.
+func createZone(ctx context.Context, tx *sql.Tx, domain string, account string, org int64) (string, error) {
+	var (
+		id  string
+		err error
+	)
+	if org == 0 {
+		err = tx.QueryRowContext(ctx, "INSERT INTO domains(name,type,account) VALUES ($1,'master',$2) RETURNING id;", domain, account).Scan(&id)
+	} else if _, err = requireRole(ctx, tx, org, account, pb.Role_Admin); err == nil {
+		err = tx.QueryRowContext(ctx, "INSERT INTO domains(name,type,account,organization) VALUES ($1,'master',$2,$3) RETURNING id;", domain, account, org).Scan(&id)
 	}
-	id, err = getDomainID(ctx, tx, in.GetDomain(), a)
 	if err != nil {
-		tx.Rollback()
-		return &pb.InitZoneResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return "", err
+	}
+	return id, addDefaultRecords(ctx, tx, id, domain)
+}
+
+// addDefaultRecords adds the SOA and NS records of a new or reset zone.
+func addDefaultRecords(ctx context.Context, tx *sql.Tx, id string, domain string) error {
+	se := genSerial()
+	_, err := tx.ExecContext(ctx, "INSERT INTO records(domain_id,name,type,content,change_date) VALUES ($1,$2,'SOA',$3,$4);", id, domain, fmt.Sprintf("%s %s %d 60 60 60 60", mname, rname, se), se)
+	if err != nil {
+		return err
+	}
+	return addRecord(ctx, tx, id, domain, pb.RRType_NS, target, defTTL)
+}
+
+func addRecord(ctx context.Context, tx *sql.Tx, id string, name string, t pb.RRType, content string, ttl int64) error {
+	if ttl == 0 {
+		ttl = defTTL
 	}
 	se := genSerial()
-	_, err = tx.ExecContext(ctx, "INSERT INTO records(domain_id,name,type,content,change_date) VALUES ($1,$2,'SOA',$3,$4);", id, in.GetDomain(), fmt.Sprintf("%s %s %d 60 60 60 60", mname, rname, se), se)
+	_, err := tx.ExecContext(ctx, "INSERT INTO records(domain_id,name,type,content,change_date,ttl) VALUES ($1,$2,$3,$4,$5,$6);", id, name, t.String(), content, se, ttl)
+	return err
+}
+
+func (s *server) InitZone(ctx context.Context, in *pb.InitZoneRequest) (*pb.InitZoneResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
 	if err != nil {
 		tx.Rollback()
-		return &pb.InitZoneResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
+	}
+	if in.GetVerify() || requireVerification {
+		_, err = authorizeDomain(ctx, tx, in.GetDomain(), a, pb.Role_Admin)
+		if status.Code(err) == codes.NotFound {
+			p, err := claimDomain(ctx, tx, in.GetDomain(), a, in.GetOrganization())
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			err = tx.Commit()
+			if err != nil {
+				return nil, err
+			}
+			return &pb.InitZoneResponse{Status: pb.ResponseStatus_Ok, Pending: p}, nil
+		}
 	}
-	_, err = tx.ExecContext(ctx, "INSERT INTO records(domain_id,name,type,content,change_date,ttl) VALUES ($1,$2,'NS',$3,$4,$5);", id, in.GetDomain(), os.Getenv("TARGET_IP"), se, defTTL)
+	_, err = initZone(ctx, tx, in.GetDomain(), a, in.GetOrganization())
 	if err != nil {
 		tx.Rollback()
-		return &pb.InitZoneResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
-		return &pb.InitZoneResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	return &pb.InitZoneResponse{Status: pb.ResponseStatus_Ok}, nil
 
@@ -239,65 +355,74 @@ func (s *server) InitZone(ctx context.Context, in *pb.InitZoneRequest) (*pb.Init
 func (s *server) RemoveZone(ctx context.Context, in *pb.RemoveZoneRequest) (*pb.RemoveZoneResponse, error) {
 	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
-		return &pb.RemoveZoneResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	a, err := getAccountID(ctx, tx)
-	id, err := getDomainID(ctx, tx, in.GetDomain(), a)
 	if err != nil {
-		return &pb.RemoveZoneResponse{Status: pb.ResponseStatus_BadRequest}, nil
+		tx.Rollback()
+		return nil, err
+	}
+	id, err := authorizeDomain(ctx, tx, in.GetDomain(), a, pb.Role_Admin)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
 	}
 	_, err = tx.ExecContext(ctx, "DELETE FROM records WHERE domain_id = $1;", id)
 	if err != nil {
 		tx.Rollback()
-		return &pb.RemoveZoneResponse{Status: pb.ResponseStatus_InternalServerError}, nil
+		return nil, err
 	}
 	_, err = tx.ExecContext(ctx, "DELETE FROM domains WHERE id = $1;", id)
 	if err != nil {
 		tx.Rollback()
-		return &pb.RemoveZoneResponse{Status: pb.ResponseStatus_InternalServerError}, nil
+		return nil, err
 	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
-		return &pb.RemoveZoneResponse{Status: pb.ResponseStatus_InternalServerError}, nil
+		return nil, err
 	}
 	return &pb.RemoveZoneResponse{Status: pb.ResponseStatus_Ok}, nil
 }
 
 func (s *server) AddRecord(ctx context.Context, in *pb.AddRecordRequest) (*pb.AddRecordResponse, error) {
+	err := validateRecord("", in.GetType(), in.GetContent())
+	if err != nil {
+		return nil, err
+	}
 	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
-		return &pb.AddRecordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	a, err := getAccountID(ctx, tx)
 	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
 	o := in.GetOrigin()
-	id, err := getDomainID(ctx, tx, o, a)
+	z, err := authorizeZone(ctx, tx, o, a, pb.Role_Editor)
 	if err != nil {
 		tx.Rollback()
-		return &pb.AddRecordResponse{Status: pb.ResponseStatus_BadRequest}, err
+		return nil, err
 	}
-	ttl := in.GetTtl()
-	if ttl == 0 {
-		ttl = defTTL
+	if !z.allows(in.GetName(), in.GetType()) {
+		tx.Rollback()
+		return nil, permissionDenied("record", in.GetName())
 	}
-	se := genSerial()
-	_, err = tx.ExecContext(ctx, "INSERT INTO records(domain_id,name,type,content,change_date,ttl) VALUES ($1,$2,$3,$4,$5,$6);", id, in.GetName(), in.GetType().String(), in.GetContent(), se, ttl)
+	err = addRecord(ctx, tx, z.ID, in.GetName(), in.GetType(), in.GetContent(), in.GetTtl())
 	if err != nil {
 		tx.Rollback()
-		return &pb.AddRecordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	err = updateSoa(ctx, tx, o, a)
 	if err != nil {
 		tx.Rollback()
-		return &pb.AddRecordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
-		return &pb.AddRecordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	return &pb.AddRecordResponse{Status: pb.ResponseStatus_Ok}, nil
 
@@ -306,85 +431,208 @@ func (s *server) AddRecord(ctx context.Context, in *pb.AddRecordRequest) (*pb.Ad
 func (s *server) RemoveRecord(ctx context.Context, in *pb.RemoveRecordRequest) (*pb.RemoveRecordResponse, error) {
 	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
-		return &pb.RemoveRecordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	a, err := getAccountID(ctx, tx)
 	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
-	id, err := getDomainID(ctx, tx, in.GetOrigin(), a)
+	z, err := authorizeZone(ctx, tx, in.GetOrigin(), a, pb.Role_Editor)
 	if err != nil {
-		return &pb.RemoveRecordResponse{Status: pb.ResponseStatus_BadRequest}, err
+		tx.Rollback()
+		return nil, err
 	}
-	_, err = tx.ExecContext(ctx, "DELETE FROM records WHERE domain_id = $1 AND name = $2 AND type = $3 AND content = $4;", id, in.GetName(), in.GetType().String(), in.GetContent())
+	if !z.allows(in.GetName(), in.GetType()) {
+		tx.Rollback()
+		return nil, permissionDenied("record", in.GetName())
+	}
+	res, err := tx.ExecContext(ctx, "DELETE FROM records WHERE domain_id = $1 AND name = $2 AND type = $3 AND content = $4;", z.ID, in.GetName(), in.GetType().String(), in.GetContent())
 	if err != nil {
 		tx.Rollback()
-		return &pb.RemoveRecordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		tx.Rollback()
+		return nil, notFound("record", in.GetName())
 	}
 	err = tx.Commit()
 	if err != nil {
-		return &pb.RemoveRecordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	return &pb.RemoveRecordResponse{Status: pb.ResponseStatus_Ok}, nil
 }
 
 func (s *server) UpdateRecord(ctx context.Context, in *pb.UpdateRecordRequest) (*pb.UpdateRecordResponse, error) {
+	c := in.GetSource()
+	err := validateRecord("source.", c.GetType(), c.GetContent())
+	if err != nil {
+		return nil, err
+	}
 	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
-		return &pb.UpdateRecordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	a, err := getAccountID(ctx, tx)
 	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
-	id, err := getDomainID(ctx, tx, in.GetOrigin(), a)
+	z, err := authorizeZone(ctx, tx, in.GetOrigin(), a, pb.Role_Editor)
 	if err != nil {
-		return &pb.UpdateRecordResponse{Status: pb.ResponseStatus_BadRequest}, err
+		tx.Rollback()
+		return nil, err
 	}
 	t := in.GetTarget()
-	c := in.GetSource()
-	_, err = tx.ExecContext(ctx, "UPDATE records SET name = $1, type = $2, ttl = $3, content = $4 WHERE name = $5 AND type = $6 AND content = $7 AND domain_id = $8;",
-		c.GetName(), c.GetType().String(), c.GetTtl(), c.GetContent(), t.GetName(), t.GetType().String(), t.GetContent(), id)
+	if !z.allows(t.GetName(), t.GetType()) || !z.allows(c.GetName(), c.GetType()) {
+		tx.Rollback()
+		return nil, permissionDenied("record", t.GetName())
+	}
+	res, err := tx.ExecContext(ctx, "UPDATE records SET name = $1, type = $2, ttl = $3, content = $4 WHERE name = $5 AND type = $6 AND content = $7 AND domain_id = $8;",
+		c.GetName(), c.GetType().String(), c.GetTtl(), c.GetContent(), t.GetName(), t.GetType().String(), t.GetContent(), z.ID)
 	if err != nil {
 		tx.Rollback()
-		return &pb.UpdateRecordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		tx.Rollback()
+		return nil, notFound("record", t.GetName())
 	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
-		return &pb.UpdateRecordResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	return &pb.UpdateRecordResponse{Status: pb.ResponseStatus_Ok}, nil
 }
 
+func inZone(name string, origin string) bool {
+	return name == origin || strings.HasSuffix(name, "."+origin)
+}
+
+// validateRRSets checks a changeset as a whole before anything is written.
+func validateRRSets(origin string, li []*pb.RRSet) error {
+	seen := make(map[string]bool, len(li))
+	for i, r := range li {
+		f := fmt.Sprintf("rrsets[%d].", i)
+		if !inZone(r.GetName(), origin) {
+			return badRequest(f+"name", fmt.Errorf("%s is out of zone %s", r.GetName(), origin))
+		}
+		if err := validateType(r.GetType()); err != nil {
+			return badRequest(f+"type", err)
+		}
+		k := r.GetName() + " " + r.GetType().String()
+		if seen[k] {
+			return badRequest(f+"name", fmt.Errorf("%s appears more than once", k))
+		}
+		seen[k] = true
+		if r.GetChangetype() == pb.RRSet_DELETE {
+			continue
+		}
+		if len(r.GetContents()) == 0 {
+			return badRequest(f+"contents", errors.New("REPLACE requires at least one content"))
+		}
+		cs := make(map[string]bool, len(r.GetContents()))
+		for j, c := range r.GetContents() {
+			if err := validateContent(r.GetType(), c); err != nil {
+				return badRequest(fmt.Sprintf("%scontents[%d]", f, j), err)
+			}
+			if cs[c] {
+				return badRequest(fmt.Sprintf("%scontents[%d]", f, j), fmt.Errorf("%q appears more than once", c))
+			}
+			cs[c] = true
+		}
+	}
+	return nil
+}
+
+func (s *server) ApplyChanges(ctx context.Context, in *pb.ApplyChangesRequest) (*pb.ApplyChangesResponse, error) {
+	o := in.GetOrigin()
+	err := validateRRSets(o, in.GetRrsets())
+	if err != nil {
+		return nil, err
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	z, err := authorizeZone(ctx, tx, o, a, pb.Role_Editor)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	for _, r := range in.GetRrsets() {
+		if !z.allows(r.GetName(), r.GetType()) {
+			tx.Rollback()
+			return nil, permissionDenied("record", r.GetName())
+		}
+	}
+	id := z.ID
+	for _, r := range in.GetRrsets() {
+		_, err = tx.ExecContext(ctx, "DELETE FROM records WHERE domain_id = $1 AND name = $2 AND type = $3;", id, r.GetName(), r.GetType().String())
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if r.GetChangetype() == pb.RRSet_DELETE {
+			continue
+		}
+		for _, c := range r.GetContents() {
+			err = addRecord(ctx, tx, id, r.GetName(), r.GetType(), c, r.GetTtl())
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+	}
+	err = updateSoa(ctx, tx, o, a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return &pb.ApplyChangesResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
 func (s *server) GetDomains(ctx context.Context, in *empty.Empty) (*pb.GetDomainsResponse, error) {
 	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
-		return &pb.GetDomainsResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	a, err := getAccountID(ctx, tx)
 	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
-	rows, err := GetDB().QueryContext(ctx, "SELECT id,name FROM domains WHERE account = $1;", a)
+	rows, err := tx.QueryContext(ctx, "SELECT id,name,COALESCE(organization,0) FROM domains WHERE (account = $1 AND organization IS NULL) OR organization IN (SELECT organization FROM members WHERE account = $1) OR id IN (SELECT domain FROM zone_shares WHERE account = $1);", a)
 	if err != nil {
 		tx.Rollback()
-		return &pb.GetDomainsResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	li := make([]*pb.Domain, 0, 10)
 	for rows.Next() {
 		item := new(pb.Domain)
-		err := rows.Scan(&item.Id, &item.Name)
+		err := rows.Scan(&item.Id, &item.Name, &item.Organization)
 		if err != nil {
+			rows.Close()
 			tx.Rollback()
-			return &pb.GetDomainsResponse{Status: pb.ResponseStatus_InternalServerError}, err
+			return nil, err
 		}
 		li = append(li, item)
 	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
-		return &pb.GetDomainsResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	return &pb.GetDomainsResponse{Status: pb.ResponseStatus_Ok, Domains: li}, nil
 }
@@ -392,20 +640,22 @@ func (s *server) GetDomains(ctx context.Context, in *empty.Empty) (*pb.GetDomain
 func (s *server) GetRecords(ctx context.Context, in *pb.GetRecordsRequest) (*pb.GetRecordsResponse, error) {
 	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
-		return &pb.GetRecordsResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	a, err := getAccountID(ctx, tx)
 	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
-	id, err := getDomainID(ctx, tx, in.GetOrigin(), a)
+	z, err := authorizeZone(ctx, tx, in.GetOrigin(), a, pb.Role_Viewer)
 	if err != nil {
-		return &pb.GetRecordsResponse{Status: pb.ResponseStatus_BadRequest}, err
+		tx.Rollback()
+		return nil, err
 	}
-	rows, err := GetDB().QueryContext(ctx, "SELECT name,type,content,ttl FROM records WHERE domain_id = $1 AND type != 'SOA';", id)
+	rows, err := tx.QueryContext(ctx, "SELECT name,type,content,ttl FROM records WHERE domain_id = $1 AND type != 'SOA';", z.ID)
 	if err != nil {
 		tx.Rollback()
-		return &pb.GetRecordsResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	li := make([]*pb.Record, 0, 10)
 	for rows.Next() {
@@ -414,15 +664,19 @@ func (s *server) GetRecords(ctx context.Context, in *pb.GetRecordsRequest) (*pb.
 		err := rows.Scan(&item.Name, &t, &item.Content, &item.Ttl)
 		item.Type = (pb.RRType)(pb.RRType_value[t])
 		if err != nil {
+			rows.Close()
 			tx.Rollback()
-			return &pb.GetRecordsResponse{Status: pb.ResponseStatus_InternalServerError}, err
+			return nil, err
+		}
+		if !z.allows(item.Name, item.Type) {
+			continue
 		}
 		li = append(li, item)
 	}
 
 	err = tx.Commit()
 	if err != nil {
-		return &pb.GetRecordsResponse{Status: pb.ResponseStatus_InternalServerError}, err
+		return nil, err
 	}
 	return &pb.GetRecordsResponse{Status: pb.ResponseStatus_Ok, Records: li}, nil
 }
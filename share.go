@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	"github.com/lib/pq"
+)
+
+// validateShare checks a share of the zone domain.
+func validateShare(domain string, sh *pb.ZoneShare) error {
+	if sh.GetEmail() == "" {
+		return badRequest("share.email", errors.New("email is required"))
+	}
+	if r := sh.GetRole(); r != pb.Role_Viewer && r != pb.Role_Editor {
+		return badRequest("share.role", fmt.Errorf("%s cannot be shared", r))
+	}
+	if s := sh.GetSubtree(); s != "" && !inZone(s, domain) {
+		return badRequest("share.subtree", fmt.Errorf("%s is out of zone %s", s, domain))
+	}
+	for i, t := range sh.GetTypes() {
+		if err := validateType(t); err != nil {
+			return badRequest(fmt.Sprintf("share.types[%d]", i), err)
+		}
+	}
+	return nil
+}
+
+// ShareZone grants a role on the zone to another account, replacing the
+// share it had before.
+func (s *server) ShareZone(ctx context.Context, in *pb.ShareZoneRequest) (*pb.ShareZoneResponse, error) {
+	sh := in.GetShare()
+	err := validateShare(in.GetDomain(), sh)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	id, err := authorizeDomain(ctx, tx, in.GetDomain(), a, pb.Role_Admin)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var target string
+	err = tx.QueryRowContext(ctx, "SELECT id FROM accounts WHERE email = $1;", sh.GetEmail()).Scan(&target)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return nil, notFound("account", sh.GetEmail())
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	types := make([]string, 0, len(sh.GetTypes()))
+	for _, t := range sh.GetTypes() {
+		types = append(types, t.String())
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO zone_shares(domain,account,role,subtree,types) VALUES ($1,$2,$3,$4,$5) ON CONFLICT (domain,account) DO UPDATE SET role = $3, subtree = $4, types = $5;",
+		id, target, sh.GetRole().String(), sh.GetSubtree(), pq.Array(types))
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ShareZoneResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
+func (s *server) UnshareZone(ctx context.Context, in *pb.UnshareZoneRequest) (*pb.UnshareZoneResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	id, err := authorizeDomain(ctx, tx, in.GetDomain(), a, pb.Role_Admin)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	res, err := tx.ExecContext(ctx, "DELETE FROM zone_shares WHERE domain = $1 AND account = (SELECT id FROM accounts WHERE email = $2);", id, in.GetEmail())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		tx.Rollback()
+		return nil, notFound("share", in.GetEmail())
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnshareZoneResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
+func (s *server) ListZoneShares(ctx context.Context, in *pb.ListZoneSharesRequest) (*pb.ListZoneSharesResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	id, err := authorizeDomain(ctx, tx, in.GetDomain(), a, pb.Role_Admin)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT a.email, s.role, s.subtree, s.types FROM zone_shares s JOIN accounts a ON a.id = s.account WHERE s.domain = $1 ORDER BY a.email;", id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	li := make([]*pb.ZoneShare, 0, 4)
+	for rows.Next() {
+		var (
+			item  = new(pb.ZoneShare)
+			role  string
+			types []string
+		)
+		err = rows.Scan(&item.Email, &role, &item.Subtree, pq.Array(&types))
+		if err != nil {
+			rows.Close()
+			tx.Rollback()
+			return nil, err
+		}
+		item.Role = parseRole(role)
+		for _, t := range types {
+			item.Types = append(item.Types, pb.RRType(pb.RRType_value[t]))
+		}
+		li = append(li, item)
+	}
+	rows.Close()
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListZoneSharesResponse{Status: pb.ResponseStatus_Ok, Shares: li}, nil
+}
@@ -2,8 +2,18 @@ package main
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"log"
 	"net"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,8 +21,11 @@ import (
 	empty "github.com/golang/protobuf/ptypes/empty"
 	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // test on ./example/docker-compose up.
@@ -41,11 +54,11 @@ func TestGetToken(t *testing.T) {
 	c := pb.NewPdnsServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	_, err = c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "foo.example.com", Password: "changeme"})
-	r, err := c.GetToken(ctx, &pb.GetTokenRequest{Email: "foo.example.com", Password: "changeme"})
+	_, err = c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "foo@example.com", Password: "Change.Me-1"})
+	r, err := c.GetToken(ctx, &pb.GetTokenRequest{Email: "foo@example.com", Password: "Change.Me-1"})
 	assert.Equal(t, r.GetStatus(), pb.ResponseStatus_Ok)
-	r, err = c.GetToken(ctx, &pb.GetTokenRequest{Email: "foo.example.com", Password: "changeme2"})
-	assert.Equal(t, r.GetStatus(), pb.ResponseStatus_BadRequest)
+	r, err = c.GetToken(ctx, &pb.GetTokenRequest{Email: "foo@example.com", Password: "Change.Me-2"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
 }
 
 func TestInitZone(t *testing.T) {
@@ -59,13 +72,13 @@ func TestInitZone(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
-	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail.example.com", Password: "changeme"})
+	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail@example.com", Password: "Change.Me-1"})
 	var token string
-	if err != nil {
+	if err != nil && status.Code(err) != codes.AlreadyExists {
 		log.Fatal(err)
 	}
-	if s := re.GetStatus().String(); s == "AlreadyExists" {
-		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail.example.com", Password: "changeme"})
+	if status.Code(err) == codes.AlreadyExists {
+		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example.com", Password: "Change.Me-1"})
 		token = res.GetToken()
 		ctx = metadata.AppendToOutgoingContext(ctx, "token", token)
 	} else {
@@ -105,13 +118,13 @@ func TestAddRecord(t *testing.T) {
 	c := pb.NewPdnsServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail.example2.com", Password: "changeme"})
+	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail@example2.com", Password: "Change.Me-1"})
 	var token string
-	if err != nil {
+	if err != nil && status.Code(err) != codes.AlreadyExists {
 		log.Fatal(err)
 	}
-	if s := re.GetStatus().String(); s == "AlreadyExists" {
-		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail.example2.com", Password: "changeme"})
+	if status.Code(err) == codes.AlreadyExists {
+		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example2.com", Password: "Change.Me-1"})
 		token = res.GetToken()
 		ctx = metadata.AppendToOutgoingContext(ctx, "token", token)
 	} else {
@@ -148,13 +161,13 @@ func TestGetRecords(t *testing.T) {
 	c := pb.NewPdnsServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail.example3.com", Password: "changeme"})
+	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail@example3.com", Password: "Change.Me-1"})
 	var token string
-	if err != nil {
+	if err != nil && status.Code(err) != codes.AlreadyExists {
 		log.Fatal(err)
 	}
-	if s := re.GetStatus().String(); s == "AlreadyExists" {
-		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail.example3.com", Password: "changeme"})
+	if status.Code(err) == codes.AlreadyExists {
+		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example3.com", Password: "Change.Me-1"})
 		token = res.GetToken()
 		ctx = metadata.AppendToOutgoingContext(ctx, "token", token)
 		_, _ = c.RemoveZone(ctx, &pb.RemoveZoneRequest{Domain: "example3.com"})
@@ -202,13 +215,13 @@ func TestRemoveRecord(t *testing.T) {
 	c := pb.NewPdnsServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail.example4.com", Password: "changeme"})
+	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail@example4.com", Password: "Change.Me-1"})
 	var token string
-	if err != nil {
+	if err != nil && status.Code(err) != codes.AlreadyExists {
 		log.Fatal(err)
 	}
-	if s := re.GetStatus().String(); s == "AlreadyExists" {
-		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail.example4.com", Password: "changeme"})
+	if status.Code(err) == codes.AlreadyExists {
+		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example4.com", Password: "Change.Me-1"})
 		token = res.GetToken()
 		ctx = metadata.AppendToOutgoingContext(ctx, "token", token)
 		_, _ = c.RemoveZone(ctx, &pb.RemoveZoneRequest{Domain: "example4.com"})
@@ -236,13 +249,13 @@ func TestUpdateRecord(t *testing.T) {
 	c := pb.NewPdnsServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail.example5.com", Password: "changeme"})
+	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail@example5.com", Password: "Change.Me-1"})
 	var token string
-	if err != nil {
+	if err != nil && status.Code(err) != codes.AlreadyExists {
 		log.Fatal(err)
 	}
-	if s := re.GetStatus().String(); s == "AlreadyExists" {
-		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail.example5.com", Password: "changeme"})
+	if status.Code(err) == codes.AlreadyExists {
+		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example5.com", Password: "Change.Me-1"})
 		token = res.GetToken()
 		ctx = metadata.AppendToOutgoingContext(ctx, "token", token)
 		_, _ = c.RemoveZone(ctx, &pb.RemoveZoneRequest{Domain: "example5.com"})
@@ -280,13 +293,13 @@ func TestRemoveZone(t *testing.T) {
 	c := pb.NewPdnsServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail.example6.com", Password: "changeme"})
+	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail@example6.com", Password: "Change.Me-1"})
 	var token string
-	if err != nil {
+	if err != nil && status.Code(err) != codes.AlreadyExists {
 		log.Fatal(err)
 	}
-	if s := re.GetStatus().String(); s == "AlreadyExists" {
-		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail.example6.com", Password: "changeme"})
+	if status.Code(err) == codes.AlreadyExists {
+		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example6.com", Password: "Change.Me-1"})
 		token = res.GetToken()
 		ctx = metadata.AppendToOutgoingContext(ctx, "token", token)
 		_, _ = c.RemoveZone(ctx, &pb.RemoveZoneRequest{Domain: "example6.com"})
@@ -320,13 +333,13 @@ func TestGetDomains(t *testing.T) {
 	c := pb.NewPdnsServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail.example7.com", Password: "changeme"})
+	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail@example7.com", Password: "Change.Me-1"})
 	var token string
-	if err != nil {
+	if err != nil && status.Code(err) != codes.AlreadyExists {
 		log.Fatal(err)
 	}
-	if s := re.GetStatus().String(); s == "AlreadyExists" {
-		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail.example7.com", Password: "changeme"})
+	if status.Code(err) == codes.AlreadyExists {
+		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example7.com", Password: "Change.Me-1"})
 		token = res.GetToken()
 		ctx = metadata.AppendToOutgoingContext(ctx, "token", token)
 		_, _ = c.RemoveZone(ctx, &pb.RemoveZoneRequest{Domain: "example7.com"})
@@ -353,14 +366,16 @@ func TestChangePassword(t *testing.T) {
 	c := pb.NewPdnsServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail.example8.com", Password: "changeme"})
+	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail@example8.com", Password: "Change.Me-1"})
 	var token string
-	if err != nil {
+	if err != nil && status.Code(err) != codes.AlreadyExists {
 		log.Fatal(err)
 	}
 	pctx := ctx
-	if s := re.GetStatus().String(); s == "AlreadyExists" {
-		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail.example8.com", Password: "changeme2"})
+	current := "Change.Me-1"
+	if status.Code(err) == codes.AlreadyExists {
+		current = "Change.Me-2"
+		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example8.com", Password: "Change.Me-2"})
 		token = res.GetToken()
 		ctx = metadata.AppendToOutgoingContext(ctx, "token", token)
 		_, _ = c.RemoveZone(ctx, &pb.RemoveZoneRequest{Domain: "example8.com"})
@@ -369,16 +384,24 @@ func TestChangePassword(t *testing.T) {
 		ctx = metadata.AppendToOutgoingContext(ctx, "token", token)
 	}
 
-	r0, err := c.ChangePassword(ctx, &pb.ChangePasswordRequest{Pass: "changeme2"})
+	for _, pass := range []string{"", "Short.1", "alllowercaseletters", "Password123!", "Mail@example8.com"} {
+		_, err = c.ChangePassword(ctx, &pb.ChangePasswordRequest{Pass: pass, Current: current})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	_, err = c.ChangePassword(ctx, &pb.ChangePasswordRequest{Pass: "Change.Me-2"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = c.ChangePassword(ctx, &pb.ChangePasswordRequest{Pass: "Change.Me-2", Current: "Wrong.Pw-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	r0, err := c.ChangePassword(ctx, &pb.ChangePasswordRequest{Pass: "Change.Me-2", Current: current})
 	if err != nil {
 		t.Error(err)
 	}
 	assert.Equal(t, r0.GetStatus(), pb.ResponseStatus_Ok)
-	r, err := c.GetToken(pctx, &pb.GetTokenRequest{Email: "mail.example8.com", Password: "changeme3"})
-	assert.Equal(t, r.GetStatus(), pb.ResponseStatus_BadRequest)
-	r2, err := c.GetToken(pctx, &pb.GetTokenRequest{Email: "mail.example8.com", Password: "changeme"})
-	assert.Equal(t, r2.GetStatus(), pb.ResponseStatus_BadRequest)
-	r3, err := c.GetToken(pctx, &pb.GetTokenRequest{Email: "mail.example8.com", Password: "changeme2"})
+	_, err = c.GetToken(pctx, &pb.GetTokenRequest{Email: "mail@example8.com", Password: "Change.Me-3"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.GetToken(pctx, &pb.GetTokenRequest{Email: "mail@example8.com", Password: "Change.Me-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	r3, err := c.GetToken(pctx, &pb.GetTokenRequest{Email: "mail@example8.com", Password: "Change.Me-2"})
 	assert.Equal(t, r3.GetStatus(), pb.ResponseStatus_Ok)
 }
 
@@ -392,13 +415,13 @@ func TestConflictDomain(t *testing.T) {
 	c := pb.NewPdnsServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail.example9.com", Password: "changeme"})
+	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail@example9.com", Password: "Change.Me-1"})
 	var token string
-	if err != nil {
+	if err != nil && status.Code(err) != codes.AlreadyExists {
 		log.Fatal(err)
 	}
-	if s := re.GetStatus().String(); s == "AlreadyExists" {
-		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail.example9.com", Password: "changeme"})
+	if status.Code(err) == codes.AlreadyExists {
+		res, _ := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example9.com", Password: "Change.Me-1"})
 		token = res.GetToken()
 		ctx = metadata.AppendToOutgoingContext(ctx, "token", token)
 	} else {
@@ -407,5 +430,957 @@ func TestConflictDomain(t *testing.T) {
 	}
 	_, err = c.InitZone(ctx, &pb.InitZoneRequest{Domain: "example.com"})
 	assert.NotEqual(t, nil, err)
-	assert.Equal(t, "rpc error: code = Unknown desc = this domain is already used by other user", err.Error())
+	assert.Equal(t, "rpc error: code = AlreadyExists desc = this domain is already used by other user", err.Error())
+}
+
+// testPassword is the password of the test accounts and of the seeded
+// admin@example.com.
+const testPassword = "Change.Me-1"
+
+// testClient is a connection to the server started by docker-compose.
+type testClient struct {
+	pb.PdnsServiceClient
+	conn   *grpc.ClientConn
+	cancel context.CancelFunc
+}
+
+// dial connects to the server and returns a context timing out after
+// timeout.
+func dial(timeout time.Duration) (*testClient, context.Context) {
+	conn, err := grpc.Dial("0.0.0.0:50051", grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("did not connect: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	return &testClient{PdnsServiceClient: pb.NewPdnsServiceClient(conn), conn: conn, cancel: cancel}, ctx
+}
+
+// Close cancels the context and closes the connection.
+func (c *testClient) Close() {
+	c.cancel()
+	c.conn.Close()
+}
+
+// signIn returns ctx with a token of email.
+func signIn(t *testing.T, c *testClient, ctx context.Context, email string) context.Context {
+	res, err := c.GetToken(ctx, &pb.GetTokenRequest{Email: email, Password: testPassword})
+	if err != nil {
+		t.Fatalf("%s: %v", email, err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "token", res.GetToken())
+}
+
+// newAccount creates the account email with testPassword, or signs in if
+// it exists, and returns ctx with its token and the token.
+func newAccount(t *testing.T, c *testClient, ctx context.Context, email string) (context.Context, string) {
+	re, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: email, Password: testPassword})
+	if status.Code(err) == codes.AlreadyExists {
+		res, err := c.GetToken(ctx, &pb.GetTokenRequest{Email: email, Password: testPassword})
+		if err != nil {
+			t.Fatalf("%s: %v", email, err)
+		}
+		return metadata.AppendToOutgoingContext(ctx, "token", res.GetToken()), res.GetToken()
+	}
+	if err != nil {
+		t.Fatalf("%s: %v", email, err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "token", re.GetToken()), re.GetToken()
+}
+
+func TestImportZone(t *testing.T) {
+	log.Println("TestImportZone")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	ctx, _ = newAccount(t, c, ctx, "mail@example10.com")
+
+	_, err := c.ImportZone(ctx, &pb.ImportZoneRequest{Domain: "example10.com", Zone: "$ORIGIN example10.com.\nwww IN A 1.2.3.4\nbad IN A 1.2.3\nmx IN MX (\n 10 )\n"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	br := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+	assert.Equal(t, len(br.GetFieldViolations()), 2)
+	assert.True(t, strings.HasPrefix(br.GetFieldViolations()[0].GetDescription(), "line 3: "))
+	assert.True(t, strings.HasPrefix(br.GetFieldViolations()[1].GetDescription(), "line 4: "))
+
+	zone := `$ORIGIN example10.com.
+$TTL 600
+@	IN	SOA	ns1 hostmaster (
+		2020010101 ; serial
+		3600 600 86400 60 )
+	IN	NS	ns1
+www	300	IN	A	10.10.10.10
+	IN	AAAA	::1
+mail	IN	MX	10 mx.example10.com.
+`
+	r, err := c.ImportZone(ctx, &pb.ImportZoneRequest{Domain: "example10.com", Zone: zone})
+	if err != nil {
+		log.Fatal(err)
+	}
+	assert.Equal(t, r.GetStatus(), pb.ResponseStatus_Ok)
+	r1, err := c.GetRecords(ctx, &pb.GetRecordsRequest{Origin: "example10.com"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	assert.Equal(t, len(r1.GetRecords()), 5)
+
+	address, err := net.ResolveIPAddr("ip", "pdns")
+	if err != nil {
+		log.Fatal(err)
+	}
+	cl := dns.Client{}
+	m := dns.Msg{}
+	m.SetQuestion("www.example10.com.", dns.TypeA)
+	res, _, err := cl.Exchange(&m, address.IP.String()+":53")
+	if err != nil {
+		log.Fatal(err)
+	}
+	assert.Equal(t, len(res.Answer), 1)
+	a := res.Answer[0].(*dns.A)
+	assert.Equal(t, a.A.String(), "10.10.10.10")
+	assert.Equal(t, a.Hdr.Ttl, uint32(300))
+}
+
+func TestExportZone(t *testing.T) {
+	log.Println("TestExportZone")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	ctx, _ = newAccount(t, c, ctx, "mail@example11.com")
+
+	_, err := c.InitZone(ctx, &pb.InitZoneRequest{Domain: "example11.com"})
+	_, err = c.AddRecord(ctx, &pb.AddRecordRequest{Name: "www.example11.com", Origin: "example11.com", Type: pb.RRType_A, Ttl: 300, Content: "11.11.11.11"})
+	r, err := c.ExportZone(ctx, &pb.ExportZoneRequest{Origin: "example11.com"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	assert.Equal(t, r.GetStatus(), pb.ResponseStatus_Ok)
+	zp := dns.NewZoneParser(strings.NewReader(r.GetZone()), "", "")
+	rrs := make([]dns.RR, 0, 3)
+	for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+		rrs = append(rrs, rr)
+	}
+	assert.Equal(t, nil, zp.Err())
+	assert.Equal(t, len(rrs), 3)
+	assert.Equal(t, rrs[0].Header().Rrtype, dns.TypeSOA)
+	assert.Equal(t, rrs[1].Header().Rrtype, dns.TypeNS)
+	assert.Equal(t, rrs[2].String(), "www.example11.com.\t300\tIN\tA\t11.11.11.11")
+
+	r2, err := c.ExportZone(ctx, &pb.ExportZoneRequest{Origin: "example11.com", Format: pb.ExportZoneRequest_Json})
+	if err != nil {
+		log.Fatal(err)
+	}
+	var li []map[string]interface{}
+	err = json.Unmarshal([]byte(r2.GetZone()), &li)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, len(li), 3)
+	assert.Equal(t, li[0]["type"], "SOA")
+}
+
+func TestApplyChanges(t *testing.T) {
+	log.Println("TestApplyChanges")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	ctx, _ = newAccount(t, c, ctx, "mail@example12.com")
+
+	_, err := c.InitZone(ctx, &pb.InitZoneRequest{Domain: "example12.com"})
+	_, err = c.AddRecord(ctx, &pb.AddRecordRequest{Name: "www.example12.com", Origin: "example12.com", Type: pb.RRType_A, Ttl: 3500, Content: "11.11.11.11"})
+	_, err = c.AddRecord(ctx, &pb.AddRecordRequest{Name: "old.example12.com", Origin: "example12.com", Type: pb.RRType_A, Ttl: 3500, Content: "33.33.33.33"})
+	_, err = c.ApplyChanges(ctx, &pb.ApplyChangesRequest{
+		Origin: "example12.com",
+		Rrsets: []*pb.RRSet{
+			{Name: "www.example12.com", Type: pb.RRType_A, Ttl: 300, Contents: []string{"22.22.22.22"}},
+			{Name: "www.example12.com", Type: pb.RRType_A, Changetype: pb.RRSet_DELETE},
+		}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	r0, err := c.GetRecords(ctx, &pb.GetRecordsRequest{Origin: "example12.com"})
+	assert.Equal(t, len(r0.GetRecords()), 3)
+
+	r, err := c.ApplyChanges(ctx, &pb.ApplyChangesRequest{
+		Origin: "example12.com",
+		Rrsets: []*pb.RRSet{
+			{Name: "www.example12.com", Type: pb.RRType_A, Ttl: 300, Contents: []string{"22.22.22.22", "44.44.44.44"}},
+			{Name: "old.example12.com", Type: pb.RRType_A, Changetype: pb.RRSet_DELETE},
+		}})
+	if err != nil {
+		log.Fatal(err)
+	}
+	assert.Equal(t, r.GetStatus(), pb.ResponseStatus_Ok)
+	r1, err := c.GetRecords(ctx, &pb.GetRecordsRequest{Origin: "example12.com"})
+	assert.Equal(t, len(r1.GetRecords()), 3)
+	for _, rec := range r1.GetRecords() {
+		if rec.GetType() == pb.RRType_A {
+			assert.Equal(t, rec.GetName(), "www.example12.com")
+			assert.Equal(t, rec.GetTtl(), int64(300))
+		}
+	}
+}
+
+func TestRecordValidation(t *testing.T) {
+	log.Println("TestRecordValidation")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	ctx, _ = newAccount(t, c, ctx, "mail@example13.com")
+
+	_, err := c.InitZone(ctx, &pb.InitZoneRequest{Domain: "example13.com"})
+	_, err = c.AddRecord(ctx, &pb.AddRecordRequest{Name: "example13.com", Origin: "example13.com", Type: pb.RRType_AAAA, Ttl: 3500, Content: "2001:db8::zz"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	d := status.Convert(err).Details()
+	assert.Equal(t, len(d), 1)
+	br := d[0].(*errdetails.BadRequest)
+	assert.Equal(t, br.GetFieldViolations()[0].GetField(), "content")
+	_, err = c.AddRecord(ctx, &pb.AddRecordRequest{Name: "example13.com", Origin: "example13.com", Type: pb.RRType_AXFR, Ttl: 3500, Content: "x"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = c.UpdateRecord(ctx,
+		&pb.UpdateRecordRequest{
+			Origin: "example13.com",
+			Target: &pb.UpdateRecordRequest_Target{Name: "example13.com", Type: pb.RRType_NS, Content: "12.34.56.78"},
+			Source: &pb.UpdateRecordRequest_Source{Name: "example13.com", Type: pb.RRType_MX, Content: "mail.example13.com", Ttl: 9999}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	r, err := c.AddRecord(ctx, &pb.AddRecordRequest{Name: "example13.com", Origin: "example13.com", Type: pb.RRType_MX, Ttl: 3500, Content: "10 mail.example13.com"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, r.GetStatus(), pb.ResponseStatus_Ok)
+}
+
+func TestAuthentication(t *testing.T) {
+	log.Println("TestAuthentication")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	_, err := c.Ping(ctx, &pb.Ping{Text: "Bob"})
+	assert.Equal(t, nil, err)
+	_, err = c.GetDomains(ctx, &empty.Empty{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	tctx, token := newAccount(t, c, ctx, "mail@example14.com")
+	// swap the payload for one claiming another subject, keeping the signature
+	s := strings.Split(token, ".")
+	s[1] = base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"mail.example.com"}`))
+	fctx := metadata.AppendToOutgoingContext(ctx, "token", strings.Join(s, "."))
+	_, err = c.GetDomains(fctx, &empty.Empty{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = c.GetDomains(tctx, &empty.Empty{})
+	assert.Equal(t, nil, err)
+}
+
+func TestRefreshToken(t *testing.T) {
+	log.Println("TestRefreshToken")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	newAccount(t, c, ctx, "mail@example15.com")
+	r0, err := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example15.com", Password: testPassword})
+	if err != nil {
+		log.Fatal(err)
+	}
+	assert.NotEqual(t, "", r0.GetRefreshToken())
+
+	r1, err := c.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: r0.GetRefreshToken()})
+	if err != nil {
+		log.Fatal(err)
+	}
+	assert.NotEqual(t, r0.GetRefreshToken(), r1.GetRefreshToken())
+	_, err = c.GetDomains(metadata.AppendToOutgoingContext(ctx, "token", r1.GetToken()), &empty.Empty{})
+	assert.Equal(t, nil, err)
+
+	// reusing a rotated token revokes the chain
+	_, err = c.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: r0.GetRefreshToken()})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: r1.GetRefreshToken()})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestLogout(t *testing.T) {
+	log.Println("TestLogout")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	newAccount(t, c, ctx, "mail@example16.com")
+	r0, err := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example16.com", Password: testPassword})
+	if err != nil {
+		log.Fatal(err)
+	}
+	r1, err := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example16.com", Password: testPassword})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx0 := metadata.AppendToOutgoingContext(ctx, "token", r0.GetToken())
+	ctx1 := metadata.AppendToOutgoingContext(ctx, "token", r1.GetToken())
+
+	_, err = c.Logout(ctx0, &pb.LogoutRequest{RefreshToken: r0.GetRefreshToken()})
+	assert.Equal(t, nil, err)
+	_, err = c.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: r0.GetRefreshToken()})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: r1.GetRefreshToken()})
+	assert.Equal(t, nil, err)
+
+	r2, err := c.ChangePassword(ctx1, &pb.ChangePasswordRequest{Pass: testPassword, Current: testPassword})
+	if err != nil {
+		log.Fatal(err)
+	}
+	_, err = c.GetDomains(ctx1, &empty.Empty{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	ctx2 := metadata.AppendToOutgoingContext(ctx, "token", r2.GetToken())
+	_, err = c.GetDomains(ctx2, &empty.Empty{})
+	assert.Equal(t, nil, err)
+
+	_, err = c.Logout(ctx2, &pb.LogoutRequest{Everywhere: true})
+	assert.Equal(t, nil, err)
+	_, err = c.GetDomains(ctx2, &empty.Empty{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: r2.GetRefreshToken()})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestGetJWKS(t *testing.T) {
+	log.Println("TestGetJWKS")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	_, token := newAccount(t, c, ctx, "mail@example17.com")
+	b, err := base64.RawURLEncoding.DecodeString(strings.Split(token, ".")[0])
+	if err != nil {
+		log.Fatal(err)
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := json.Unmarshal(b, &header); err != nil {
+		log.Fatal(err)
+	}
+	assert.NotEqual(t, "", header.Kid)
+
+	r1, err := c.GetJWKS(ctx, &empty.Empty{})
+	assert.Equal(t, nil, err)
+	var key *pb.JWK
+	for _, k := range r1.GetKeys() {
+		if k.GetKid() == header.Kid {
+			key = k
+		}
+	}
+	if assert.NotNil(t, key) {
+		assert.Equal(t, header.Alg, key.GetAlg())
+		assert.Equal(t, "RSA", key.GetKty())
+		assert.Equal(t, "sig", key.GetUse())
+		assert.NotEqual(t, "", key.GetN())
+	}
+}
+
+func TestAPIKeys(t *testing.T) {
+	log.Println("TestAPIKeys")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	tctx, _ := newAccount(t, c, ctx, "mail@example18.com")
+	_, err := c.InitZone(tctx, &pb.InitZoneRequest{Domain: "example18.com"})
+	_, err = c.InitZone(tctx, &pb.InitZoneRequest{Domain: "other.example18.com"})
+
+	_, err = c.CreateAPIKey(tctx, &pb.CreateAPIKeyRequest{Name: "ci", Zones: []string{"example18.com"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = c.CreateAPIKey(tctx, &pb.CreateAPIKeyRequest{Name: "ci", Scope: pb.APIKeyScope_Full, Zones: []string{"example12.com"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	r0, err := c.CreateAPIKey(tctx, &pb.CreateAPIKeyRequest{Name: "ci", Scope: pb.APIKeyScope_ReadOnly, Zones: []string{"example18.com"}})
+	assert.Equal(t, nil, err)
+	rctx := metadata.AppendToOutgoingContext(ctx, "apikey", r0.GetSecret())
+	_, err = c.GetRecords(rctx, &pb.GetRecordsRequest{Origin: "example18.com"})
+	assert.Equal(t, nil, err)
+	_, err = c.GetRecords(rctx, &pb.GetRecordsRequest{Origin: "other.example18.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = c.AddRecord(rctx, &pb.AddRecordRequest{Name: "www.example18.com", Origin: "example18.com", Type: pb.RRType_A, Ttl: 3600, Content: "11.11.11.11"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	r1, err := c.GetDomains(rctx, &empty.Empty{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(r1.GetDomains()))
+	_, err = c.CreateAPIKey(rctx, &pb.CreateAPIKeyRequest{Name: "escalate", Scope: pb.APIKeyScope_Full})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	r2, err := c.CreateAPIKey(tctx, &pb.CreateAPIKeyRequest{Name: "acme", Scope: pb.APIKeyScope_AcmeTXT})
+	assert.Equal(t, nil, err)
+	actx := metadata.AppendToOutgoingContext(ctx, "apikey", r2.GetSecret())
+	_, err = c.AddRecord(actx, &pb.AddRecordRequest{Name: "_acme-challenge.other.example18.com", Origin: "other.example18.com", Type: pb.RRType_TXT, Ttl: 60, Content: "\"token\""})
+	assert.Equal(t, nil, err)
+	_, err = c.AddRecord(actx, &pb.AddRecordRequest{Name: "www.other.example18.com", Origin: "other.example18.com", Type: pb.RRType_A, Ttl: 60, Content: "11.11.11.11"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	r3, err := c.ListAPIKeys(tctx, &empty.Empty{})
+	assert.Equal(t, nil, err)
+	for _, k := range r3.GetKeys() {
+		if k.GetId() == r0.GetKey().GetId() {
+			assert.NotEqual(t, int64(0), k.GetLastUsedAt())
+			assert.Equal(t, []string{"example18.com"}, k.GetZones())
+		}
+	}
+
+	_, err = c.RevokeAPIKey(tctx, &pb.RevokeAPIKeyRequest{Id: r0.GetKey().GetId()})
+	assert.Equal(t, nil, err)
+	_, err = c.GetRecords(rctx, &pb.GetRecordsRequest{Origin: "example18.com"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.RevokeAPIKey(tctx, &pb.RevokeAPIKeyRequest{Id: -1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestOrganizations(t *testing.T) {
+	log.Println("TestOrganizations")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	octx, _ := newAccount(t, c, ctx, "mail@example19.com")
+	mctx, _ := newAccount(t, c, ctx, "member@example19.com")
+
+	var org int64
+	r0, err := c.CreateOrganization(octx, &pb.CreateOrganizationRequest{Name: "example19"})
+	if status.Code(err) == codes.AlreadyExists {
+		r1, _ := c.GetOrganizations(octx, &empty.Empty{})
+		for _, o := range r1.GetOrganizations() {
+			if o.GetName() == "example19" {
+				org = o.GetId()
+			}
+		}
+	} else {
+		assert.Equal(t, nil, err)
+		assert.Equal(t, pb.Role_Owner, r0.GetOrganization().GetRole())
+		org = r0.GetOrganization().GetId()
+	}
+
+	_, err = c.InitZone(mctx, &pb.InitZoneRequest{Domain: "example19.com", Organization: org})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = c.InitZone(octx, &pb.InitZoneRequest{Domain: "example19.com", Organization: org})
+	assert.Equal(t, nil, err)
+	_, err = c.GetRecords(mctx, &pb.GetRecordsRequest{Origin: "example19.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = c.InviteMember(mctx, &pb.InviteMemberRequest{Organization: org, Email: "member@example19.com", Role: pb.Role_Owner})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = c.InviteMember(octx, &pb.InviteMemberRequest{Organization: org, Email: "member@example19.com", Role: pb.Role_Viewer})
+	assert.Equal(t, nil, err)
+	_, err = c.InviteMember(octx, &pb.InviteMemberRequest{Organization: org, Email: "member@example19.com", Role: pb.Role_Editor})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	r2, err := c.GetMembers(mctx, &pb.GetMembersRequest{Organization: org})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(r2.GetMembers()))
+
+	r3, err := c.GetDomains(mctx, &empty.Empty{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(r3.GetDomains()))
+	assert.Equal(t, org, r3.GetDomains()[0].GetOrganization())
+	_, err = c.GetRecords(mctx, &pb.GetRecordsRequest{Origin: "example19.com"})
+	assert.Equal(t, nil, err)
+	_, err = c.AddRecord(mctx, &pb.AddRecordRequest{Name: "www.example19.com", Origin: "example19.com", Type: pb.RRType_A, Ttl: 3600, Content: "11.11.11.11"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = c.InitZone(mctx, &pb.InitZoneRequest{Domain: "example19.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = c.RemoveMember(mctx, &pb.RemoveMemberRequest{Organization: org, Email: "mail@example19.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = c.RemoveMember(octx, &pb.RemoveMemberRequest{Organization: org, Email: "mail@example19.com"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = c.RemoveMember(octx, &pb.RemoveMemberRequest{Organization: org, Email: "member@example19.com"})
+	assert.Equal(t, nil, err)
+	_, err = c.GetRecords(mctx, &pb.GetRecordsRequest{Origin: "example19.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestZoneShares(t *testing.T) {
+	log.Println("TestZoneShares")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	octx, _ := newAccount(t, c, ctx, "mail@example20.com")
+	cctx, _ := newAccount(t, c, ctx, "contractor@example20.com")
+
+	_, err := c.InitZone(octx, &pb.InitZoneRequest{Domain: "example20.com"})
+	_, err = c.AddRecord(octx, &pb.AddRecordRequest{Name: "www.example20.com", Origin: "example20.com", Type: pb.RRType_A, Ttl: 3600, Content: "11.11.11.11"})
+	_, err = c.ShareZone(octx, &pb.ShareZoneRequest{Domain: "example20.com", Share: &pb.ZoneShare{Email: "contractor@example20.com", Role: pb.Role_Owner}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = c.ShareZone(octx, &pb.ShareZoneRequest{Domain: "example20.com", Share: &pb.ZoneShare{Email: "contractor@example20.com", Role: pb.Role_Editor, Subtree: "dev.example20.com", Types: []pb.RRType{pb.RRType_A}}})
+	assert.Equal(t, nil, err)
+
+	_, err = c.AddRecord(cctx, &pb.AddRecordRequest{Name: "www.dev.example20.com", Origin: "example20.com", Type: pb.RRType_A, Ttl: 3600, Content: "22.22.22.22"})
+	assert.Equal(t, nil, err)
+	_, err = c.AddRecord(cctx, &pb.AddRecordRequest{Name: "api.example20.com", Origin: "example20.com", Type: pb.RRType_A, Ttl: 3600, Content: "22.22.22.22"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = c.AddRecord(cctx, &pb.AddRecordRequest{Name: "dev.example20.com", Origin: "example20.com", Type: pb.RRType_TXT, Ttl: 3600, Content: "\"hello\""})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = c.RemoveZone(cctx, &pb.RemoveZoneRequest{Domain: "example20.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	r0, err := c.GetRecords(cctx, &pb.GetRecordsRequest{Origin: "example20.com"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(r0.GetRecords()))
+	r1, err := c.GetDomains(cctx, &empty.Empty{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(r1.GetDomains()))
+
+	_, err = c.ListZoneShares(cctx, &pb.ListZoneSharesRequest{Domain: "example20.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	r2, err := c.ListZoneShares(octx, &pb.ListZoneSharesRequest{Domain: "example20.com"})
+	assert.Equal(t, nil, err)
+	if assert.Equal(t, 1, len(r2.GetShares())) {
+		assert.Equal(t, "dev.example20.com", r2.GetShares()[0].GetSubtree())
+		assert.Equal(t, []pb.RRType{pb.RRType_A}, r2.GetShares()[0].GetTypes())
+	}
+
+	_, err = c.UnshareZone(octx, &pb.UnshareZoneRequest{Domain: "example20.com", Email: "contractor@example20.com"})
+	assert.Equal(t, nil, err)
+	_, err = c.GetRecords(cctx, &pb.GetRecordsRequest{Origin: "example20.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestZoneTransfer(t *testing.T) {
+	log.Println("TestZoneTransfer")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	octx, _ := newAccount(t, c, ctx, "mail@example21.com")
+	nctx, _ := newAccount(t, c, ctx, "new@example21.com")
+
+	_, err := c.InitZone(octx, &pb.InitZoneRequest{Domain: "example21.com"})
+	_, err = c.AddRecord(octx, &pb.AddRecordRequest{Name: "www.example21.com", Origin: "example21.com", Type: pb.RRType_A, Ttl: 3600, Content: "11.11.11.11"})
+	_, err = c.TransferZone(nctx, &pb.TransferZoneRequest{Domain: "example21.com", Email: "new@example21.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = c.TransferZone(octx, &pb.TransferZoneRequest{Domain: "example21.com", Email: "mail@example21.com"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	r0, err := c.TransferZone(octx, &pb.TransferZoneRequest{Domain: "example21.com", Email: "new@example21.com"})
+	assert.Equal(t, nil, err)
+	id := r0.GetTransfer().GetId()
+
+	r1, err := c.ListZoneTransfers(nctx, &empty.Empty{})
+	assert.Equal(t, nil, err)
+	if assert.NotEqual(t, 0, len(r1.GetTransfers())) {
+		assert.Equal(t, id, r1.GetTransfers()[0].GetId())
+		assert.Equal(t, pb.ZoneTransfer_Pending, r1.GetTransfers()[0].GetState())
+	}
+	_, err = c.AcceptZoneTransfer(octx, &pb.AcceptZoneTransferRequest{Id: id})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = c.AcceptZoneTransfer(nctx, &pb.AcceptZoneTransferRequest{Id: id})
+	assert.Equal(t, nil, err)
+	_, err = c.AcceptZoneTransfer(nctx, &pb.AcceptZoneTransferRequest{Id: id})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	r2, err := c.GetRecords(nctx, &pb.GetRecordsRequest{Origin: "example21.com"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(r2.GetRecords()))
+	_, err = c.GetRecords(octx, &pb.GetRecordsRequest{Origin: "example21.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	r3, err := c.TransferZone(nctx, &pb.TransferZoneRequest{Domain: "example21.com", Email: "mail@example21.com"})
+	assert.Equal(t, nil, err)
+	_, err = c.CancelZoneTransfer(octx, &pb.CancelZoneTransferRequest{Id: r3.GetTransfer().GetId()})
+	assert.Equal(t, nil, err)
+	_, err = c.AcceptZoneTransfer(octx, &pb.AcceptZoneTransferRequest{Id: r3.GetTransfer().GetId()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	r4, err := c.TransferZone(nctx, &pb.TransferZoneRequest{Domain: "example21.com", Email: "mail@example21.com"})
+	assert.Equal(t, nil, err)
+	_, err = c.AcceptZoneTransfer(octx, &pb.AcceptZoneTransferRequest{Id: r4.GetTransfer().GetId()})
+	assert.Equal(t, nil, err)
+}
+
+func TestVerifyZone(t *testing.T) {
+	log.Println("TestVerifyZone")
+	c, ctx := dial(5 * time.Second)
+	defer c.Close()
+	octx, _ := newAccount(t, c, ctx, "mail@example22.com")
+	sctx, _ := newAccount(t, c, ctx, "squatter@example22.com")
+
+	r0, err := c.InitZone(octx, &pb.InitZoneRequest{Domain: "example22.com", Verify: true})
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, "", r0.GetPending().GetChallenge())
+	assert.Equal(t, "_pdns-challenge.example22.com", r0.GetPending().GetTxtName())
+	r1, err := c.InitZone(octx, &pb.InitZoneRequest{Domain: "example22.com", Verify: true})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, r0.GetPending().GetChallenge(), r1.GetPending().GetChallenge())
+	r2, err := c.InitZone(sctx, &pb.InitZoneRequest{Domain: "example22.com", Verify: true})
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, r0.GetPending().GetChallenge(), r2.GetPending().GetChallenge())
+
+	_, err = c.GetRecords(octx, &pb.GetRecordsRequest{Origin: "example22.com"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = c.VerifyZone(octx, &pb.VerifyZoneRequest{Domain: "example22.com"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = c.VerifyZone(octx, &pb.VerifyZoneRequest{Domain: "unclaimed.example22.com"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// totp computes the current RFC 6238 code of a base32 encoded secret.
+func totp(secret string) string {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(time.Now().Unix()/30))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	off := sum[len(sum)-1] & 0x0f
+	return fmt.Sprintf("%06d", (binary.BigEndian.Uint32(sum[off:off+4])&0x7fffffff)%1000000)
+}
+
+func TestTOTP(t *testing.T) {
+	log.Println("TestTOTP")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	tctx, _ := newAccount(t, c, ctx, "mail@example23.com")
+
+	r0, err := c.EnrollTOTP(tctx, &empty.Empty{})
+	assert.Equal(t, nil, err)
+	assert.True(t, strings.HasPrefix(r0.GetUri(), "otpauth://totp/"))
+	_, err = c.ConfirmTOTP(tctx, &pb.ConfirmTOTPRequest{Code: "000000x"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	code := totp(r0.GetSecret())
+	r1, err := c.ConfirmTOTP(tctx, &pb.ConfirmTOTPRequest{Code: code})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 10, len(r1.GetRecoveryCodes()))
+	_, err = c.EnrollTOTP(tctx, &empty.Empty{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example23.com", Password: testPassword})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example23.com", Password: testPassword, Otp: code})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example23.com", Password: testPassword, Otp: r1.GetRecoveryCodes()[0]})
+	assert.Equal(t, nil, err)
+	_, err = c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example23.com", Password: testPassword, Otp: r1.GetRecoveryCodes()[0]})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = c.DisableTOTP(tctx, &pb.DisableTOTPRequest{Code: r1.GetRecoveryCodes()[1]})
+	assert.Equal(t, nil, err)
+	_, err = c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example23.com", Password: testPassword})
+	assert.Equal(t, nil, err)
+}
+
+// readMail returns the latest mail written by the file mailer to to.
+func readMail(to string) string {
+	files, err := ioutil.ReadDir("mail")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var latest string
+	for _, f := range files {
+		b, err := ioutil.ReadFile(filepath.Join("mail", f.Name()))
+		if err != nil {
+			log.Fatal(err)
+		}
+		if strings.Contains(string(b), "\r\nTo: "+to+"\r\n") {
+			latest = string(b)
+		}
+	}
+	return latest
+}
+
+// waitMail waits for a mail to to, as mail is sent in the background.
+func waitMail(to string) string {
+	for i := 0; i < 20; i++ {
+		if m := readMail(to); m != "" {
+			return m
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return ""
+}
+
+func TestPasswordReset(t *testing.T) {
+	log.Println("TestPasswordReset")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	newAccount(t, c, ctx, "mail@example24.com")
+	_, err := c.ResetPassword(ctx, &pb.ResetPasswordRequest{Token: "invalid", Password: testPassword})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{Email: "nobody@example24.com"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", readMail("nobody@example24.com"))
+
+	_, err = c.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{Email: "mail@example24.com"})
+	assert.Equal(t, nil, err)
+	m := waitMail("mail@example24.com")
+	i := strings.Index(m, "Reset token: ")
+	if !assert.NotEqual(t, -1, i) {
+		return
+	}
+	token := strings.TrimSpace(strings.SplitN(m[i+len("Reset token: "):], "\r\n", 2)[0])
+
+	_, err = c.ResetPassword(ctx, &pb.ResetPasswordRequest{Token: token, Password: "changed"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = c.ResetPassword(ctx, &pb.ResetPasswordRequest{Token: token, Password: "Changed.Pw-1"})
+	assert.Equal(t, nil, err)
+	_, err = c.ResetPassword(ctx, &pb.ResetPasswordRequest{Token: token, Password: testPassword})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example24.com", Password: testPassword})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	r0, err := c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example24.com", Password: "Changed.Pw-1"})
+	assert.Equal(t, nil, err)
+	tctx := metadata.AppendToOutgoingContext(ctx, "token", r0.GetToken())
+	_, err = c.ChangePassword(tctx, &pb.ChangePasswordRequest{Pass: testPassword, Current: "Changed.Pw-1"})
+	assert.Equal(t, nil, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = c.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{Email: "nobody@example24.com"})
+		assert.Equal(t, nil, err)
+	}
+	_, err = c.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{Email: "nobody@example24.com"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestVerifyEmail(t *testing.T) {
+	log.Println("TestVerifyEmail")
+	c, ctx := dial(time.Second)
+	defer c.Close()
+	_, err := c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail.example25.com", Password: testPassword})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "Mail <mail@example25.com>", Password: testPassword})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = c.CreateAccount(ctx, &pb.CreateAccountRequest{Email: "mail@example25.com", Password: "changeme"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	tctx, _ := newAccount(t, c, ctx, "mail@example25.com")
+	for _, domain := range []string{"example25.com", "example25.net", "example25.org"} {
+		_, err = c.InitZone(tctx, &pb.InitZoneRequest{Domain: domain})
+		assert.Equal(t, nil, err)
+	}
+	_, err = c.InitZone(tctx, &pb.InitZoneRequest{Domain: "example25.info"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = c.VerifyEmail(ctx, &pb.VerifyEmailRequest{Token: "invalid"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.ResendVerification(tctx, &empty.Empty{})
+	assert.Equal(t, nil, err)
+	m := readMail("mail@example25.com")
+	i := strings.Index(m, "Verification token: ")
+	if !assert.NotEqual(t, -1, i) {
+		return
+	}
+	token := strings.TrimSpace(strings.SplitN(m[i+len("Verification token: "):], "\r\n", 2)[0])
+	_, err = c.VerifyEmail(ctx, &pb.VerifyEmailRequest{Token: token})
+	assert.Equal(t, nil, err)
+	_, err = c.VerifyEmail(ctx, &pb.VerifyEmailRequest{Token: token})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.ResendVerification(tctx, &empty.Empty{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = c.InitZone(tctx, &pb.InitZoneRequest{Domain: "example25.info"})
+	assert.Equal(t, nil, err)
+}
+
+func TestLoginLockout(t *testing.T) {
+	log.Println("TestLoginLockout")
+	c, ctx := dial(5 * time.Second)
+	defer c.Close()
+	newAccount(t, c, ctx, "mail@example26.com")
+	_, err := c.GetToken(ctx, &pb.GetTokenRequest{Email: "nobody@example26.com", Password: testPassword})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	unknown := status.Convert(err).Message()
+	for i := 0; i < 5; i++ {
+		_, err = c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example26.com", Password: "wrong"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Equal(t, unknown, status.Convert(err).Message())
+	}
+	_, err = c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example26.com", Password: testPassword})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = c.UnlockAccount(signIn(t, c, ctx, "mail@example25.com"), &pb.UnlockAccountRequest{Email: "mail@example26.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	actx := signIn(t, c, ctx, "admin@example.com")
+	_, err = c.UnlockAccount(actx, &pb.UnlockAccountRequest{Address: "not an address"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = c.UnlockAccount(actx, &pb.UnlockAccountRequest{Email: "mail@example26.com"})
+	assert.Equal(t, nil, err)
+	_, err = c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example26.com", Password: testPassword})
+	assert.Equal(t, nil, err)
+}
+
+func TestDeleteAccount(t *testing.T) {
+	log.Println("TestDeleteAccount")
+	c, ctx := dial(5 * time.Second)
+	defer c.Close()
+	tctx, _ := newAccount(t, c, ctx, "mail@example27.com")
+	_, err := c.InitZone(tctx, &pb.InitZoneRequest{Domain: "example27.com"})
+	assert.Equal(t, nil, err)
+	_, err = c.AddRecord(tctx, &pb.AddRecordRequest{Name: "www.example27.com", Origin: "example27.com", Type: pb.RRType_A, Ttl: 3500, Content: "27.27.27.27"})
+	assert.Equal(t, nil, err)
+
+	r0, err := c.ExportAccountData(tctx, &empty.Empty{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	var data struct {
+		Email string `json:"email"`
+		Zones []struct {
+			Name    string `json:"name"`
+			Records []struct {
+				Name    string `json:"name"`
+				Content string `json:"content"`
+			} `json:"records"`
+		} `json:"zones"`
+	}
+	assert.Equal(t, nil, json.Unmarshal(r0.GetData(), &data))
+	assert.Equal(t, "mail@example27.com", data.Email)
+	if assert.Equal(t, 1, len(data.Zones)) {
+		assert.Equal(t, "example27.com", data.Zones[0].Name)
+		assert.Contains(t, string(r0.GetData()), "27.27.27.27")
+	}
+	assert.NotContains(t, string(r0.GetData()), "password")
+	for _, k := range []string{"suspended_at", "client_certificates", "oidc_identities", "pending_zones", "transfers"} {
+		assert.Contains(t, string(r0.GetData()), `"`+k+`"`)
+	}
+
+	_, err = c.DeleteAccount(tctx, &pb.DeleteAccountRequest{Password: "Wrong.Pw-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.DeleteAccount(tctx, &pb.DeleteAccountRequest{Password: testPassword})
+	assert.Equal(t, nil, err)
+	_, err = c.GetToken(ctx, &pb.GetTokenRequest{Email: "mail@example27.com", Password: testPassword})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	nctx, _ := newAccount(t, c, ctx, "new@example27.com")
+	_, err = c.InitZone(nctx, &pb.InitZoneRequest{Domain: "example27.com"})
+	assert.Equal(t, nil, err)
+	_, err = c.RemoveZone(nctx, &pb.RemoveZoneRequest{Domain: "example27.com"})
+	assert.Equal(t, nil, err)
+}
+
+func TestAdminService(t *testing.T) {
+	log.Println("TestAdminService")
+	c, ctx := dial(5 * time.Second)
+	defer c.Close()
+	ac := pb.NewAdminServiceClient(c.conn)
+	uctx, _ := newAccount(t, c, ctx, "mail@example28.com")
+	_, err := c.InitZone(uctx, &pb.InitZoneRequest{Domain: "example28.com"})
+	assert.Equal(t, nil, err)
+	_, err = ac.ListAccounts(uctx, &pb.ListAccountsRequest{Query: "example28"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	actx := signIn(t, c, ctx, "admin@example.com")
+	r0, err := ac.ListAccounts(actx, &pb.ListAccountsRequest{Query: "example28"})
+	assert.Equal(t, nil, err)
+	if assert.Equal(t, 1, len(r0.GetAccounts())) {
+		assert.Equal(t, "mail@example28.com", r0.GetAccounts()[0].GetEmail())
+		assert.Equal(t, int64(1), r0.GetAccounts()[0].GetZones())
+	}
+	r1, err := ac.ListZones(actx, &pb.ListZonesRequest{Email: "mail@example28.com"})
+	assert.Equal(t, nil, err)
+	if assert.Equal(t, 1, len(r1.GetZones())) {
+		assert.Equal(t, "example28.com", r1.GetZones()[0].GetName())
+	}
+
+	r2, err := ac.Impersonate(actx, &pb.ImpersonateRequest{Email: "mail@example28.com"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ictx := metadata.AppendToOutgoingContext(ctx, "token", r2.GetToken())
+	r3, err := c.GetDomains(ictx, &empty.Empty{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(r3.GetDomains()))
+	_, err = c.AddRecord(ictx, &pb.AddRecordRequest{Name: "www.example28.com", Origin: "example28.com", Type: pb.RRType_A, Ttl: 3500, Content: "28.28.28.28"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ac.ListAccounts(ictx, &pb.ListAccountsRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ac.SuspendAccount(actx, &pb.SuspendAccountRequest{Email: "mail@example28.com", Reason: "test"})
+	assert.Equal(t, nil, err)
+	_, err = c.GetDomains(uctx, &empty.Empty{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	uctx = signIn(t, c, ctx, "mail@example28.com")
+	_, err = c.GetDomains(uctx, &empty.Empty{})
+	assert.Equal(t, nil, err)
+	_, err = c.AddRecord(uctx, &pb.AddRecordRequest{Name: "www.example28.com", Origin: "example28.com", Type: pb.RRType_A, Ttl: 3500, Content: "28.28.28.28"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = ac.UnsuspendAccount(actx, &pb.UnsuspendAccountRequest{Email: "mail@example28.com"})
+	assert.Equal(t, nil, err)
+	_, err = c.AddRecord(uctx, &pb.AddRecordRequest{Name: "www.example28.com", Origin: "example28.com", Type: pb.RRType_A, Ttl: 3500, Content: "28.28.28.28"})
+	assert.Equal(t, nil, err)
+
+	_, err = ac.ReassignZone(actx, &pb.ReassignZoneRequest{Domain: "example28.com", Email: "admin@example.com"})
+	assert.Equal(t, nil, err)
+	r3, err = c.GetDomains(uctx, &empty.Empty{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(r3.GetDomains()))
+	_, err = ac.ForceRemoveZone(actx, &pb.ForceRemoveZoneRequest{Domain: "example28.com"})
+	assert.Equal(t, nil, err)
+	_, err = ac.ForceRemoveZone(actx, &pb.ForceRemoveZoneRequest{Domain: "example28.com"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSuspendedZones(t *testing.T) {
+	log.Println("TestSuspendedZones")
+	c, ctx := dial(5 * time.Second)
+	defer c.Close()
+	ac := pb.NewAdminServiceClient(c.conn)
+	uctx, _ := newAccount(t, c, ctx, "mail@example29.com")
+	_, err := c.InitZone(uctx, &pb.InitZoneRequest{Domain: "example29.com"})
+	assert.Equal(t, nil, err)
+	_, err = c.AddRecord(uctx, &pb.AddRecordRequest{Name: "example29.com", Origin: "example29.com", Type: pb.RRType_A, Ttl: 3500, Content: "29.29.29.29"})
+	assert.Equal(t, nil, err)
+	actx := signIn(t, c, ctx, "admin@example.com")
+	address, err := net.ResolveIPAddr("ip", "pdns")
+	if err != nil {
+		log.Fatal(err)
+	}
+	lookup := func() int {
+		cl := dns.Client{}
+		m := dns.Msg{}
+		m.SetQuestion("example29.com.", dns.TypeA)
+		r, _, err := cl.Exchange(&m, address.IP.String()+":53")
+		if err != nil {
+			log.Fatal(err)
+		}
+		return len(r.Answer)
+	}
+	assert.Equal(t, 1, lookup())
+
+	_, err = ac.SuspendAccount(actx, &pb.SuspendAccountRequest{Email: "mail@example29.com", Reason: "unpaid"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, lookup())
+	_, err = ac.SuspendAccount(actx, &pb.SuspendAccountRequest{Email: "mail@example29.com", Reason: "unpaid"})
+	assert.Equal(t, nil, err)
+	_, err = ac.UnsuspendAccount(actx, &pb.UnsuspendAccountRequest{Email: "mail@example29.com"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, lookup())
+}
+
+func TestAuditEvents(t *testing.T) {
+	log.Println("TestAuditEvents")
+	c, ctx := dial(5 * time.Second)
+	defer c.Close()
+	since := time.Now().Add(-time.Minute).Unix()
+	uctx, _ := newAccount(t, c, ctx, "mail@example30.com")
+	_, err := c.InitZone(uctx, &pb.InitZoneRequest{Domain: "example30.com"})
+	assert.Equal(t, nil, err)
+	_, err = c.AddRecord(uctx, &pb.AddRecordRequest{Name: "www.example30.com", Origin: "example30.com", Type: pb.RRType_A, Ttl: 3500, Content: "30.30.30.30"})
+	assert.Equal(t, nil, err)
+	_, err = c.RemoveRecord(uctx, &pb.RemoveRecordRequest{Name: "www.example30.com", Origin: "example30.com", Type: pb.RRType_A, Content: "30.30.30.30"})
+	assert.Equal(t, nil, err)
+	_, err = c.ChangePassword(uctx, &pb.ChangePasswordRequest{Pass: "Change.Me-2", Current: "Wrong.Pw-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	r0, err := c.ListAuditEvents(uctx, &pb.ListAuditEventsRequest{Zone: "example30.com", Since: since})
+	assert.Equal(t, nil, err)
+	if assert.Equal(t, 3, len(r0.GetEvents())) {
+		e := r0.GetEvents()[0]
+		assert.Equal(t, "/api.PdnsService/RemoveRecord", e.GetMethod())
+		assert.Equal(t, "mail@example30.com", e.GetEmail())
+		assert.Equal(t, "OK", e.GetCode())
+		assert.Contains(t, e.GetPayload(), "www.example30.com")
+		assert.NotEqual(t, int64(0), e.GetCreatedAt())
+	}
+	r1, err := c.ListAuditEvents(uctx, &pb.ListAuditEventsRequest{})
+	assert.Equal(t, nil, err)
+	for _, e := range r1.GetEvents() {
+		assert.Equal(t, "mail@example30.com", e.GetEmail())
+		assert.NotContains(t, e.GetPayload(), "Wrong.Pw-1")
+	}
+	if assert.NotEqual(t, 0, len(r1.GetEvents())) {
+		assert.Equal(t, "/api.PdnsService/ChangePassword", r1.GetEvents()[0].GetMethod())
+		assert.Equal(t, "Unauthenticated", r1.GetEvents()[0].GetCode())
+	}
+	r2, err := c.ListAuditEvents(uctx, &pb.ListAuditEventsRequest{Zone: "example30.com", Until: since})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(r2.GetEvents()))
+	_, err = c.ListAuditEvents(uctx, &pb.ListAuditEventsRequest{Email: "admin@example.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	actx := signIn(t, c, ctx, "admin@example.com")
+	r3, err := c.ListAuditEvents(actx, &pb.ListAuditEventsRequest{Email: "mail@example30.com", Since: since})
+	assert.Equal(t, nil, err)
+	if assert.Equal(t, 5, len(r3.GetEvents())) {
+		e := r3.GetEvents()[4]
+		assert.Equal(t, "/api.PdnsService/CreateAccount", e.GetMethod())
+		assert.NotContains(t, e.GetPayload(), testPassword)
+	}
+
+	nctx, _ := newAccount(t, c, ctx, "new@example30.com")
+	_, err = pb.NewAdminServiceClient(c.conn).ReassignZone(actx, &pb.ReassignZoneRequest{Domain: "example30.com", Email: "new@example30.com"})
+	assert.Equal(t, nil, err)
+	r4, err := c.ListAuditEvents(nctx, &pb.ListAuditEventsRequest{Zone: "example30.com"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(r4.GetEvents()))
+	r5, err := c.ListAuditEvents(actx, &pb.ListAuditEventsRequest{Zone: "example30.com", Since: since})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 4, len(r5.GetEvents()))
 }
@@ -100,6 +100,191 @@ CREATE EXTENSION pgcrypto;
 
 CREATE TABLE accounts (
   id                    SERIAL PRIMARY KEY,
-  email                 VARCHAR(40) NOT NULL UNIQUE,
-  password              TEXT NOT NULL
+  email                 VARCHAR(254) NOT NULL UNIQUE,
+  email_verified        BOOL NOT NULL DEFAULT 'f',
+  is_admin              BOOL NOT NULL DEFAULT 'f',
+  password              TEXT DEFAULT NULL,
+  token_generation      INT NOT NULL DEFAULT 0,
+  totp_secret           TEXT DEFAULT NULL,
+  totp_enabled          BOOL NOT NULL DEFAULT 'f',
+  totp_last_step        BIGINT NOT NULL DEFAULT 0,
+  suspended_at          TIMESTAMPTZ DEFAULT NULL,
+  suspended_reason      TEXT NOT NULL DEFAULT ''
 );
+
+CREATE TABLE suspended_records (
+  record                BIGINT PRIMARY KEY REFERENCES records(id) ON DELETE CASCADE,
+  account               INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  disabled              BOOL NOT NULL
+);
+
+CREATE INDEX suspended_records_account_idx ON suspended_records(account);
+
+CREATE TABLE recovery_codes (
+  id                    SERIAL PRIMARY KEY,
+  account               INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  code_hash             VARCHAR(64) NOT NULL,
+  used                  BOOL NOT NULL DEFAULT 'f'
+);
+
+CREATE INDEX recovery_codes_account_idx ON recovery_codes(account);
+
+CREATE TABLE refresh_tokens (
+  id                    SERIAL PRIMARY KEY,
+  account               INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  family                VARCHAR(64) NOT NULL,
+  token_hash            VARCHAR(64) NOT NULL UNIQUE,
+  used                  BOOL NOT NULL DEFAULT 'f',
+  revoked               BOOL NOT NULL DEFAULT 'f',
+  expires_at            TIMESTAMP WITH TIME ZONE NOT NULL,
+  created_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX refresh_tokens_family_idx ON refresh_tokens(family);
+CREATE INDEX refresh_tokens_account_idx ON refresh_tokens(account);
+
+CREATE TABLE api_keys (
+  id                    SERIAL PRIMARY KEY,
+  account               INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  name                  VARCHAR(255) NOT NULL,
+  key_hash              VARCHAR(64) NOT NULL UNIQUE,
+  scope                 VARCHAR(16) NOT NULL,
+  zones                 VARCHAR(255)[] NOT NULL DEFAULT '{}',
+  revoked               BOOL NOT NULL DEFAULT 'f',
+  expires_at            TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+  last_used_at          TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+  created_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX api_keys_account_idx ON api_keys(account);
+
+CREATE TABLE organizations (
+  id                    SERIAL PRIMARY KEY,
+  name                  VARCHAR(255) NOT NULL UNIQUE,
+  created_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE TABLE members (
+  organization          INT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  account               INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  role                  VARCHAR(16) NOT NULL,
+  PRIMARY KEY(organization, account)
+);
+
+CREATE INDEX members_account_idx ON members(account);
+
+ALTER TABLE domains ADD COLUMN organization INT DEFAULT NULL REFERENCES organizations(id);
+CREATE INDEX domains_organization_idx ON domains(organization);
+
+CREATE TABLE zone_shares (
+  domain                INT NOT NULL REFERENCES domains(id) ON DELETE CASCADE,
+  account               INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  role                  VARCHAR(16) NOT NULL,
+  subtree               VARCHAR(255) NOT NULL DEFAULT '',
+  types                 VARCHAR(10)[] NOT NULL DEFAULT '{}',
+  PRIMARY KEY(domain, account)
+);
+
+CREATE INDEX zone_shares_account_idx ON zone_shares(account);
+
+CREATE TABLE zone_transfers (
+  id                    SERIAL PRIMARY KEY,
+  domain                INT DEFAULT NULL REFERENCES domains(id) ON DELETE SET NULL,
+  name                  VARCHAR(255) NOT NULL,
+  from_account          INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  to_account            INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  state                 VARCHAR(16) NOT NULL,
+  created_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  completed_at          TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
+
+CREATE INDEX zone_transfers_domain_idx ON zone_transfers(domain);
+CREATE INDEX zone_transfers_from_idx ON zone_transfers(from_account);
+CREATE INDEX zone_transfers_to_idx ON zone_transfers(to_account);
+
+CREATE TABLE pending_domains (
+  id                    SERIAL PRIMARY KEY,
+  name                  VARCHAR(255) NOT NULL,
+  account               INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  organization          INT DEFAULT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  challenge             VARCHAR(64) NOT NULL,
+  expires_at            TIMESTAMP WITH TIME ZONE NOT NULL,
+  created_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  UNIQUE(name, account)
+);
+
+CREATE TABLE password_resets (
+  id                    SERIAL PRIMARY KEY,
+  account               INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  token_hash            VARCHAR(64) NOT NULL UNIQUE,
+  used                  BOOL NOT NULL DEFAULT 'f',
+  expires_at            TIMESTAMP WITH TIME ZONE NOT NULL,
+  created_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX password_resets_account_idx ON password_resets(account);
+
+CREATE TABLE email_verifications (
+  id                    SERIAL PRIMARY KEY,
+  account               INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  token_hash            VARCHAR(64) NOT NULL UNIQUE,
+  used                  BOOL NOT NULL DEFAULT 'f',
+  expires_at            TIMESTAMP WITH TIME ZONE NOT NULL,
+  created_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX email_verifications_account_idx ON email_verifications(account);
+
+CREATE TABLE login_attempts (
+  kind                  VARCHAR(8) NOT NULL,
+  subject               VARCHAR(254) NOT NULL,
+  failures              INT NOT NULL DEFAULT 0,
+  locked_until          TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+  updated_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  PRIMARY KEY(kind, subject)
+);
+
+CREATE TABLE oidc_identities (
+  issuer                VARCHAR(255) NOT NULL,
+  subject               VARCHAR(255) NOT NULL,
+  account               INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  created_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  PRIMARY KEY(issuer, subject)
+);
+
+CREATE INDEX oidc_identities_account_idx ON oidc_identities(account);
+
+CREATE TABLE client_certificates (
+  issuer                VARCHAR(1024) NOT NULL,
+  subject               VARCHAR(1024) NOT NULL,
+  account               INT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  name                  VARCHAR(255) NOT NULL DEFAULT '',
+  created_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  last_used_at          TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+  PRIMARY KEY(issuer, subject)
+);
+
+CREATE INDEX client_certificates_account_idx ON client_certificates(account);
+
+CREATE TABLE audit_events (
+  id                    BIGSERIAL PRIMARY KEY,
+  email                 VARCHAR(254) NOT NULL DEFAULT '',
+  api_key               INT DEFAULT NULL,
+  method                VARCHAR(128) NOT NULL,
+  zone                  VARCHAR(255) NOT NULL DEFAULT '',
+  domain                INT DEFAULT NULL,
+  owner                 INT DEFAULT NULL,
+  organization          INT DEFAULT NULL,
+  payload               TEXT NOT NULL,
+  code                  VARCHAR(32) NOT NULL,
+  peer                  VARCHAR(64) NOT NULL DEFAULT '',
+  created_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX audit_events_zone_idx ON audit_events(zone, created_at);
+CREATE INDEX audit_events_domain_idx ON audit_events(domain, created_at);
+CREATE INDEX audit_events_email_idx ON audit_events(email, created_at);
+CREATE INDEX audit_events_created_idx ON audit_events(created_at);
+
+CREATE RULE audit_events_no_update AS ON UPDATE TO audit_events DO INSTEAD NOTHING;
+CREATE RULE audit_events_no_delete AS ON DELETE TO audit_events DO INSTEAD NOTHING;
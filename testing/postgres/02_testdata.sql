@@ -0,0 +1 @@
+INSERT INTO accounts(email,password,email_verified,is_admin) VALUES ('admin@example.com',crypt('Change.Me-1', gen_salt('bf')),'t','t');
@@ -0,0 +1,305 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	jwt "github.com/dgrijalva/jwt-go"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// TLSReloader holds the server certificate and the client CAs, reloading
+// them when the files change.
+type TLSReloader struct {
+	mu       sync.RWMutex
+	paths    []string
+	modTimes map[string]time.Time
+	cert     *tls.Certificate
+	clientCA *x509.CertPool
+}
+
+// NewTLSReloader loads the files set by TLS_CERT, TLS_KEY and
+// TLS_CLIENT_CA.
+func NewTLSReloader() (*TLSReloader, error) {
+	r := &TLSReloader{paths: []string{tlsCert, tlsKey}}
+	if tlsClientCA != "" {
+		r.paths = append(r.paths, tlsClientCA)
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the files again. On error the files loaded before are kept.
+func (r *TLSReloader) Reload() error {
+	mod := make(map[string]time.Time)
+	for _, p := range r.paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+		mod[p] = fi.ModTime()
+	}
+	cert, err := tls.LoadX509KeyPair(tlsCert, tlsKey)
+	if err != nil {
+		return err
+	}
+	var pool *x509.CertPool
+	if tlsClientCA != "" {
+		b, err := ioutil.ReadFile(tlsClientCA)
+		if err != nil {
+			return err
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return fmt.Errorf("%s: no certificate found", tlsClientCA)
+		}
+	}
+	r.mu.Lock()
+	r.cert = &cert
+	r.clientCA = pool
+	r.modTimes = mod
+	r.mu.Unlock()
+	logger.Info("loaded tls certificate", zap.String("cert", tlsCert), zap.Bool("clientCA", pool != nil))
+	return nil
+}
+
+// changed reports whether a file was modified since the last reload.
+func (r *TLSReloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, p := range r.paths {
+		fi, err := os.Stat(p)
+		if err != nil || !fi.ModTime().Equal(r.modTimes[p]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Watch reloads the files when they change or on SIGHUP, so renewed
+// certificates are used without a restart.
+func (r *TLSReloader) Watch(interval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if !r.changed() {
+				continue
+			}
+		case <-hup:
+		}
+		if err := r.Reload(); err != nil {
+			logger.Error("failed to reload tls certificate", zap.Error(err))
+		}
+	}
+}
+
+// Config returns the TLS configuration of the listener. Each handshake
+// uses the files loaded last.
+func (r *TLSReloader) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			c := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				NextProtos:   []string{"h2"},
+			}
+			if r.clientCA != nil {
+				c.ClientCAs = r.clientCA
+				c.ClientAuth = tls.VerifyClientCertIfGiven
+				if tlsClientAuth == "require" {
+					c.ClientAuth = tls.RequireAndVerifyClientCert
+				}
+			}
+			return c, nil
+		},
+	}
+}
+
+// Credentials returns the gRPC transport credentials.
+func (r *TLSReloader) Credentials() credentials.TransportCredentials {
+	return credentials.NewTLS(r.Config())
+}
+
+// peerCertificate returns the client certificate verified against
+// TLS_CLIENT_CA, or nil.
+func peerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return info.State.VerifiedChains[0][0]
+}
+
+// CertificateHandler authenticates a client by the issuer and the subject
+// of its certificate. The issuer is part of the key, as TLS_CLIENT_CA may
+// hold several CAs which can issue certificates with the same subject.
+// The account is used like with a full scope API key, so account
+// management is not allowed.
+func CertificateHandler(ctx context.Context, cert *x509.Certificate) (context.Context, error) {
+	var (
+		key       apiKey
+		email     string
+		suspended bool
+	)
+	err := GetDB().QueryRowContext(ctx, "SELECT a.email, a.suspended_at IS NOT NULL FROM client_certificates c JOIN accounts a ON a.id = c.account WHERE c.issuer = $1 AND c.subject = $2;",
+		cert.Issuer.String(), cert.Subject.String()).Scan(&email, &suspended)
+	if err == sql.ErrNoRows {
+		return nil, unauthenticated("client certificate is not registered")
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	_, err = GetDB().ExecContext(ctx, "UPDATE client_certificates SET last_used_at = now() WHERE issuer = $1 AND subject = $2;", cert.Issuer.String(), cert.Subject.String())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	key.Scope = pb.APIKeyScope_Full
+	info := &JwtInfo{StandardClaims: jwt.StandardClaims{Subject: email}}
+	ctx = context.WithValue(ctx, k, info)
+	if suspended {
+		ctx = restrict(ctx, accountSuspended())
+	}
+	return context.WithValue(ctx, apiKeyContextKey, &key), nil
+}
+
+// RegisterClientCertificate maps the issuer and the subject of the client
+// certificate of the connection to the account. Calling it over the
+// connection proves that the caller holds the certificate.
+func (s *server) RegisterClientCertificate(ctx context.Context, in *pb.RegisterClientCertificateRequest) (*pb.RegisterClientCertificateResponse, error) {
+	cert := peerCertificate(ctx)
+	if cert == nil {
+		return nil, failedPrecondition("certificate", "connect with a client certificate signed by TLS_CLIENT_CA")
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	issuer, subject := cert.Issuer.String(), cert.Subject.String()
+	var owner string
+	err = tx.QueryRowContext(ctx, "SELECT account FROM client_certificates WHERE issuer = $1 AND subject = $2;", issuer, subject).Scan(&owner)
+	if err == nil {
+		tx.Rollback()
+		return nil, alreadyExists("certificate", subject, "this certificate is already registered")
+	}
+	if err != sql.ErrNoRows {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO client_certificates(issuer,subject,account,name) VALUES ($1,$2,$3,$4);", issuer, subject, a, in.GetName())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RegisterClientCertificateResponse{Status: pb.ResponseStatus_Ok, Subject: subject, Issuer: issuer}, nil
+}
+
+// ListClientCertificates returns the certificates mapped to the account.
+func (s *server) ListClientCertificates(ctx context.Context, in *empty.Empty) (*pb.ListClientCertificatesResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT issuer, subject, name, created_at, last_used_at FROM client_certificates WHERE account = $1 ORDER BY created_at;", a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	li := make([]*pb.ClientCertificate, 0, 4)
+	for rows.Next() {
+		var (
+			item     = new(pb.ClientCertificate)
+			created  time.Time
+			lastUsed pq.NullTime
+		)
+		err = rows.Scan(&item.Issuer, &item.Subject, &item.Name, &created, &lastUsed)
+		if err != nil {
+			rows.Close()
+			tx.Rollback()
+			return nil, err
+		}
+		item.CreatedAt = created.Unix()
+		item.LastUsedAt = unixTime(lastUsed)
+		li = append(li, item)
+	}
+	rows.Close()
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListClientCertificatesResponse{Status: pb.ResponseStatus_Ok, Certificates: li}, nil
+}
+
+// RemoveClientCertificate removes a certificate of the account.
+func (s *server) RemoveClientCertificate(ctx context.Context, in *pb.RemoveClientCertificateRequest) (*pb.RemoveClientCertificateResponse, error) {
+	if in.GetSubject() == "" {
+		return nil, badRequest("subject", errors.New("subject is required"))
+	}
+	if in.GetIssuer() == "" {
+		return nil, badRequest("issuer", errors.New("issuer is required"))
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	res, err := tx.ExecContext(ctx, "DELETE FROM client_certificates WHERE issuer = $1 AND subject = $2 AND account = $3;", in.GetIssuer(), in.GetSubject(), a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		tx.Rollback()
+		return nil, notFound("certificate", in.GetSubject())
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RemoveClientCertificateResponse{Status: pb.ResponseStatus_Ok}, nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// writeCert writes a self-signed certificate for cn and its key.
+func writeCert(t *testing.T, certPath string, keyPath string, cn string) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, priv.Public(), priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b}), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTLSReloader(t *testing.T) {
+	logger = zap.NewNop()
+	dir, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	tlsCert, tlsKey = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	tlsClientCA, tlsClientAuth = filepath.Join(dir, "ca.pem"), "require"
+	defer func() { tlsCert, tlsKey, tlsClientCA, tlsClientAuth = "", "", "", "optional" }()
+	writeCert(t, tlsCert, tlsKey, "one.example.com")
+	writeCert(t, tlsClientCA, filepath.Join(dir, "ca.key"), "ca.example.com")
+
+	r, err := NewTLSReloader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	commonName := func() string {
+		c, err := r.Config().GetConfigForClient(&tls.ClientHelloInfo{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tls.RequireAndVerifyClientCert, c.ClientAuth)
+		leaf, err := x509.ParseCertificate(c.Certificates[0].Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return leaf.Subject.CommonName
+	}
+	assert.Equal(t, "one.example.com", commonName())
+	assert.False(t, r.changed())
+
+	writeCert(t, tlsCert, tlsKey, "two.example.com")
+	later := time.Now().Add(time.Minute)
+	os.Chtimes(tlsCert, later, later)
+	assert.True(t, r.changed())
+	assert.Equal(t, nil, r.Reload())
+	assert.Equal(t, "two.example.com", commonName())
+
+	ioutil.WriteFile(tlsKey, []byte("broken"), 0600)
+	assert.NotEqual(t, nil, r.Reload())
+	assert.Equal(t, "two.example.com", commonName())
+}
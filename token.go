@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+)
+
+// randomString returns n random bytes encoded for use in tokens.
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken returns the digest under which a token is stored, so that a
+// leaked table does not leak usable tokens.
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// newRefreshToken stores a new refresh token for account. Tokens which
+// are rotated from one another share a family.
+func newRefreshToken(ctx context.Context, tx *sql.Tx, account string, family string) (string, error) {
+	var err error
+	if family == "" {
+		family, err = randomString(24)
+		if err != nil {
+			return "", err
+		}
+	}
+	token, err := randomString(32)
+	if err != nil {
+		return "", err
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO refresh_tokens(account,family,token_hash,expires_at) VALUES ($1,$2,$3,$4);",
+		account, family, hashToken(token), time.Now().Add(refreshTokenTTL))
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// issueTokens returns an access token and a refresh token starting a new
+// family for the account.
+func issueTokens(ctx context.Context, tx *sql.Tx, account string, email string) (string, string, error) {
+	var (
+		gen   int64
+		admin bool
+	)
+	err := tx.QueryRowContext(ctx, "SELECT token_generation, is_admin FROM accounts WHERE id = $1;", account).Scan(&gen, &admin)
+	if err != nil {
+		return "", "", err
+	}
+	refresh, err := newRefreshToken(ctx, tx, account, "")
+	if err != nil {
+		return "", "", err
+	}
+	token, err := authInstance.GenerateJWTToken(email, gen, admin)
+	if err != nil {
+		return "", "", err
+	}
+	setAuditEmail(ctx, email)
+	return token, refresh, nil
+}
+
+func (s *server) RefreshToken(ctx context.Context, in *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	if in.GetRefreshToken() == "" {
+		return nil, badRequest("refreshToken", errors.New("refresh token is required"))
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		id, account, family, email string
+		used, revoked, expired     bool
+		admin                      bool
+		gen                        int64
+	)
+	err = tx.QueryRowContext(ctx, "SELECT r.id, r.account, r.family, r.used, r.revoked, r.expires_at < now(), a.email, a.token_generation, a.is_admin FROM refresh_tokens r JOIN accounts a ON a.id = r.account WHERE r.token_hash = $1;",
+		hashToken(in.GetRefreshToken())).Scan(&id, &account, &family, &used, &revoked, &expired, &email, &gen, &admin)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return nil, unauthenticated("refresh token is invalid")
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	setAuditEmail(ctx, email)
+	if used && !revoked {
+		// A rotated token was presented again, so either side may be an
+		// attacker. Revoke the whole chain.
+		_, err = tx.ExecContext(ctx, "UPDATE refresh_tokens SET revoked = true WHERE family = $1;", family)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		err = tx.Commit()
+		if err != nil {
+			return nil, err
+		}
+		return nil, unauthenticated("refresh token was reused, the session is revoked")
+	}
+	if used || revoked || expired {
+		tx.Rollback()
+		return nil, unauthenticated("refresh token is invalid")
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE refresh_tokens SET used = true WHERE id = $1;", id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	refresh, err := newRefreshToken(ctx, tx, account, family)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	token, err := authInstance.GenerateJWTToken(email, gen, admin)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RefreshTokenResponse{Status: pb.ResponseStatus_Ok, Token: token, RefreshToken: refresh}, nil
+}
+
+// revokeTokens invalidates every access and refresh token of account.
+func revokeTokens(ctx context.Context, tx *sql.Tx, account string) error {
+	_, err := tx.ExecContext(ctx, "UPDATE accounts SET token_generation = token_generation + 1 WHERE id = $1;", account)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE refresh_tokens SET revoked = true WHERE account = $1;", account)
+	return err
+}
+
+func (s *server) Logout(ctx context.Context, in *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	if !in.GetEverywhere() && in.GetRefreshToken() == "" {
+		return nil, badRequest("refreshToken", errors.New("refresh token is required unless logging out everywhere"))
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if in.GetEverywhere() {
+		err = revokeTokens(ctx, tx, a)
+	} else {
+		_, err = tx.ExecContext(ctx, "UPDATE refresh_tokens SET revoked = true WHERE account = $1 AND family = (SELECT family FROM refresh_tokens WHERE token_hash = $2);", a, hashToken(in.GetRefreshToken()))
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LogoutResponse{Status: pb.ResponseStatus_Ok}, nil
+}
@@ -0,0 +1,344 @@
+package main
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	totpIssuer    = "pdns-grpc"
+	totpPeriod    = 30
+	totpSkew      = 1
+	recoveryCodes = 10
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// totpCode computes the RFC 6238 code of secret for the time step.
+func totpCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	off := sum[len(sum)-1] & 0x0f
+	v := binary.BigEndian.Uint32(sum[off:off+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", v%1000000)
+}
+
+// checkTOTP returns the time step matching code, allowing for clock skew.
+// Steps up to last have been used already and are rejected.
+func checkTOTP(secret []byte, code string, now time.Time, last int64) (int64, bool) {
+	step := now.Unix() / totpPeriod
+	for i := -totpSkew; i <= totpSkew; i++ {
+		s := step + int64(i)
+		if s <= last {
+			continue
+		}
+		if hmac.Equal([]byte(totpCode(secret, s)), []byte(code)) {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// sealSecret encrypts a TOTP secret with TOTP_ENCRYPTION_KEY, binding it
+// to the account.
+func sealSecret(secret []byte, account string) (string, error) {
+	gcm, err := totpCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	b := gcm.Seal(nonce, nonce, secret, []byte(account))
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+func openSecret(sealed string, account string) ([]byte, error) {
+	gcm, err := totpCipher()
+	if err != nil {
+		return nil, err
+	}
+	b, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < gcm.NonceSize() {
+		return nil, errors.New("sealed secret is too short")
+	}
+	return gcm.Open(nil, b[:gcm.NonceSize()], b[gcm.NonceSize():], []byte(account))
+}
+
+func totpCipher() (cipher.AEAD, error) {
+	if len(totpKey) == 0 {
+		return nil, failedPrecondition("totp", "two-factor authentication is not configured on this server")
+	}
+	block, err := aes.NewCipher(totpKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(code), "-", "", -1))
+}
+
+// newRecoveryCodes replaces the recovery codes of account.
+func newRecoveryCodes(ctx context.Context, tx *sql.Tx, account string) ([]string, error) {
+	_, err := tx.ExecContext(ctx, "DELETE FROM recovery_codes WHERE account = $1;", account)
+	if err != nil {
+		return nil, err
+	}
+	li := make([]string, 0, recoveryCodes)
+	for i := 0; i < recoveryCodes; i++ {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		c := strings.ToLower(b32.EncodeToString(b))
+		_, err = tx.ExecContext(ctx, "INSERT INTO recovery_codes(account,code_hash) VALUES ($1,$2);", account, hashToken(c))
+		if err != nil {
+			return nil, err
+		}
+		li = append(li, c[:4]+"-"+c[4:])
+	}
+	return li, nil
+}
+
+// checkOTP verifies a TOTP code or consumes a recovery code of account.
+func checkOTP(ctx context.Context, tx *sql.Tx, account string, sealed string, last int64, otp string) (bool, error) {
+	secret, err := openSecret(sealed, account)
+	if err != nil {
+		return false, err
+	}
+	if step, ok := checkTOTP(secret, strings.TrimSpace(otp), time.Now(), last); ok {
+		_, err = tx.ExecContext(ctx, "UPDATE accounts SET totp_last_step = $1 WHERE id = $2;", step, account)
+		return err == nil, err
+	}
+	res, err := tx.ExecContext(ctx, "UPDATE recovery_codes SET used = true WHERE account = $1 AND code_hash = $2 AND NOT used;", account, hashToken(normalizeRecoveryCode(otp)))
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+// requireOTP is returned by GetToken when the account has two-factor
+// authentication enabled and no otp was given.
+func requireOTP() error {
+	return withDetails(status.New(codes.Unauthenticated, "one-time password is required"), &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "otp", Description: "one-time password is required"}},
+	})
+}
+
+// verifyLogin checks the second factor of account if it is enabled.
+func verifyLogin(ctx context.Context, tx *sql.Tx, account string, otp string) error {
+	var (
+		enabled bool
+		sealed  sql.NullString
+		last    int64
+	)
+	err := tx.QueryRowContext(ctx, "SELECT totp_enabled, totp_secret, totp_last_step FROM accounts WHERE id = $1;", account).Scan(&enabled, &sealed, &last)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return nil
+	}
+	if otp == "" {
+		return requireOTP()
+	}
+	ok, err := checkOTP(ctx, tx, account, sealed.String, last, otp)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return unauthenticated("one-time password is incorrect")
+	}
+	return nil
+}
+
+// EnrollTOTP generates a new secret. It is not required at login until it
+// is confirmed with ConfirmTOTP.
+func (s *server) EnrollTOTP(ctx context.Context, in *empty.Empty) (*pb.EnrollTOTPResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var enabled bool
+	err = tx.QueryRowContext(ctx, "SELECT totp_enabled FROM accounts WHERE id = $1;", a).Scan(&enabled)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if enabled {
+		tx.Rollback()
+		return nil, failedPrecondition("totp", "two-factor authentication is already enabled")
+	}
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	sealed, err := sealSecret(secret, a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE accounts SET totp_secret = $1, totp_last_step = 0 WHERE id = $2;", sealed, a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	info, _ := getInfo(ctx)
+	enc := b32.EncodeToString(secret)
+	q := url.Values{}
+	q.Set("secret", enc)
+	q.Set("issuer", totpIssuer)
+	u := url.URL{Scheme: "otpauth", Host: "totp", Path: "/" + totpIssuer + ":" + info.Subject, RawQuery: q.Encode()}
+	return &pb.EnrollTOTPResponse{Status: pb.ResponseStatus_Ok, Secret: enc, Uri: u.String()}, nil
+}
+
+// ConfirmTOTP enables two-factor authentication once the enrolled secret
+// produces a valid code, and returns the recovery codes.
+func (s *server) ConfirmTOTP(ctx context.Context, in *pb.ConfirmTOTPRequest) (*pb.ConfirmTOTPResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var (
+		enabled bool
+		sealed  sql.NullString
+	)
+	err = tx.QueryRowContext(ctx, "SELECT totp_enabled, totp_secret FROM accounts WHERE id = $1;", a).Scan(&enabled, &sealed)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if enabled || !sealed.Valid {
+		tx.Rollback()
+		return nil, failedPrecondition("totp", "call EnrollTOTP first")
+	}
+	secret, err := openSecret(sealed.String, a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	step, ok := checkTOTP(secret, in.GetCode(), time.Now(), 0)
+	if !ok {
+		tx.Rollback()
+		return nil, badRequest("code", errors.New("code is incorrect"))
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE accounts SET totp_enabled = true, totp_last_step = $1 WHERE id = $2;", step, a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	rc, err := newRecoveryCodes(ctx, tx, a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ConfirmTOTPResponse{Status: pb.ResponseStatus_Ok, RecoveryCodes: rc}, nil
+}
+
+// DisableTOTP turns two-factor authentication off. It takes a current
+// code or a recovery code, so a stolen access token alone is not enough.
+// Wrong codes count as failed logins of the account.
+func (s *server) DisableTOTP(ctx context.Context, in *pb.DisableTOTPRequest) (*pb.DisableTOTPResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var (
+		enabled bool
+		sealed  sql.NullString
+		last    int64
+	)
+	err = tx.QueryRowContext(ctx, "SELECT totp_enabled, totp_secret, totp_last_step FROM accounts WHERE id = $1;", a).Scan(&enabled, &sealed, &last)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if !enabled {
+		tx.Rollback()
+		return nil, failedPrecondition("totp", "two-factor authentication is not enabled")
+	}
+	info, _ := getInfo(ctx)
+	addr := peerAddr(ctx)
+	if err := checkLoginLocked(ctx, info.Subject, addr); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	ok, err := checkOTP(ctx, tx, a, sealed.String, last, in.GetCode())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if !ok {
+		tx.Rollback()
+		loginFailed(ctx, info.Subject, addr)
+		return nil, badRequest("code", errors.New("code is incorrect"))
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE accounts SET totp_enabled = false, totp_secret = NULL, totp_last_step = 0 WHERE id = $1;", a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM recovery_codes WHERE account = $1;", a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DisableTOTPResponse{Status: pb.ResponseStatus_Ok}, nil
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// Test vectors of RFC 6238, truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	assert.Equal(t, "287082", totpCode(secret, 59/totpPeriod))
+	assert.Equal(t, "081804", totpCode(secret, 1111111109/totpPeriod))
+	assert.Equal(t, "005924", totpCode(secret, 1234567890/totpPeriod))
+	assert.Equal(t, "279037", totpCode(secret, 2000000000/totpPeriod))
+
+	now := time.Unix(1234567890, 0)
+	step, ok := checkTOTP(secret, "005924", now.Add(totpPeriod*time.Second), 0)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1234567890/totpPeriod), step)
+	_, ok = checkTOTP(secret, "005924", now, step)
+	assert.False(t, ok)
+	_, ok = checkTOTP(secret, "005924", now.Add(5*totpPeriod*time.Second), 0)
+	assert.False(t, ok)
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+var (
+	transferPending   = pb.ZoneTransfer_Pending.String()
+	transferAccepted  = pb.ZoneTransfer_Accepted.String()
+	transferCancelled = pb.ZoneTransfer_Cancelled.String()
+)
+
+// TransferZone offers the zone to another account. The zone moves when
+// the recipient accepts, and an earlier pending offer is cancelled.
+func (s *server) TransferZone(ctx context.Context, in *pb.TransferZoneRequest) (*pb.TransferZoneResponse, error) {
+	if in.GetEmail() == "" {
+		return nil, badRequest("email", errors.New("email is required"))
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	id, err := authorizeDomain(ctx, tx, in.GetDomain(), a, pb.Role_Owner)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var to string
+	err = tx.QueryRowContext(ctx, "SELECT id FROM accounts WHERE email = $1;", in.GetEmail()).Scan(&to)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return nil, notFound("account", in.GetEmail())
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if to == a {
+		tx.Rollback()
+		return nil, badRequest("email", errors.New("the zone cannot be transferred to its owner"))
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE zone_transfers SET state = $1, completed_at = now() WHERE domain = $2 AND state = $3;", transferCancelled, id, transferPending)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	info, _ := getInfo(ctx)
+	t := &pb.ZoneTransfer{Domain: in.GetDomain(), From: info.Subject, To: in.GetEmail(), State: pb.ZoneTransfer_Pending}
+	var created time.Time
+	err = tx.QueryRowContext(ctx, "INSERT INTO zone_transfers(domain,name,from_account,to_account,state) VALUES ($1,$2,$3,$4,$5) RETURNING id, created_at;",
+		id, in.GetDomain(), a, to, transferPending).Scan(&t.Id, &created)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	t.CreatedAt = created.Unix()
+	return &pb.TransferZoneResponse{Status: pb.ResponseStatus_Ok, Transfer: t}, nil
+}
+
+// pendingTransfer returns a pending transfer which account is a party
+// of. Transfers of other accounts are reported as not found.
+func pendingTransfer(ctx context.Context, tx *sql.Tx, id int64, account string) (domain sql.NullString, name string, from string, to string, err error) {
+	var state string
+	err = tx.QueryRowContext(ctx, "SELECT domain, name, from_account, to_account, state FROM zone_transfers WHERE id = $1;", id).Scan(&domain, &name, &from, &to, &state)
+	if err == sql.ErrNoRows || (err == nil && from != account && to != account) {
+		err = notFound("zone transfer", strconv.FormatInt(id, 10))
+		return
+	}
+	if err != nil {
+		return
+	}
+	if state != transferPending {
+		err = failedPrecondition("zone transfer", "the transfer is already "+state)
+	}
+	return
+}
+
+// AcceptZoneTransfer moves the zone to the recipient. Shares granted by
+// the previous owner are dropped.
+func (s *server) AcceptZoneTransfer(ctx context.Context, in *pb.AcceptZoneTransferRequest) (*pb.AcceptZoneTransferResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	domain, name, from, to, err := pendingTransfer(ctx, tx, in.GetId(), a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if to != a {
+		tx.Rollback()
+		return nil, permissionDenied("zone transfer", strconv.FormatInt(in.GetId(), 10))
+	}
+	id, err := authorizeDomain(ctx, tx, name, from, pb.Role_Owner)
+	if err != nil || !domain.Valid || id != domain.String {
+		tx.Rollback()
+		return nil, failedPrecondition("zone transfer", "the zone is no longer owned by the sender")
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE domains SET account = $1, organization = NULL WHERE id = $2;", a, id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM zone_shares WHERE domain = $1;", id)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE zone_transfers SET state = $1, completed_at = now() WHERE id = $2;", transferAccepted, in.GetId())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("zone transferred", zap.String("domain", name), zap.String("from", from), zap.String("to", a))
+	return &pb.AcceptZoneTransferResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
+// CancelZoneTransfer withdraws a transfer, or declines it when called by
+// the recipient.
+func (s *server) CancelZoneTransfer(ctx context.Context, in *pb.CancelZoneTransferRequest) (*pb.CancelZoneTransferResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, _, _, _, err = pendingTransfer(ctx, tx, in.GetId(), a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE zone_transfers SET state = $1, completed_at = now() WHERE id = $2;", transferCancelled, in.GetId())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CancelZoneTransferResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
+// ListZoneTransfers returns the transfers sent and received by the
+// account, newest first.
+func (s *server) ListZoneTransfers(ctx context.Context, in *empty.Empty) (*pb.ListZoneTransfersResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT t.id, t.name, f.email, r.email, t.state, t.created_at, t.completed_at FROM zone_transfers t JOIN accounts f ON f.id = t.from_account JOIN accounts r ON r.id = t.to_account WHERE t.from_account = $1 OR t.to_account = $1 ORDER BY t.id DESC;", a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	li := make([]*pb.ZoneTransfer, 0, 4)
+	for rows.Next() {
+		var (
+			item      = new(pb.ZoneTransfer)
+			state     string
+			created   time.Time
+			completed pq.NullTime
+		)
+		err = rows.Scan(&item.Id, &item.Domain, &item.From, &item.To, &state, &created, &completed)
+		if err != nil {
+			rows.Close()
+			tx.Rollback()
+			return nil, err
+		}
+		item.State = pb.ZoneTransfer_State(pb.ZoneTransfer_State_value[state])
+		item.CreatedAt = created.Unix()
+		item.CompletedAt = unixTime(completed)
+		li = append(li, item)
+	}
+	rows.Close()
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListZoneTransfersResponse{Status: pb.ResponseStatus_Ok, Transfers: li}, nil
+}
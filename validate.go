@@ -0,0 +1,240 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	"github.com/miekg/dns"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// metaTypes only appear in queries or on the wire, never in a zone.
+var metaTypes = map[pb.RRType]bool{
+	pb.RRType_ANY:      true,
+	pb.RRType_AXFR:     true,
+	pb.RRType_IXFR:     true,
+	pb.RRType_MAILA:    true,
+	pb.RRType_MAILB:    true,
+	pb.RRType_OPT:      true,
+	pb.RRType_TSIG:     true,
+	pb.RRType_TKEY:     true,
+	pb.RRType_None:     true,
+	pb.RRType_Reserved: true,
+}
+
+var caaTag = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+
+// badRequest builds an InvalidArgument status carrying a BadRequest
+// detail for field.
+func badRequest(field string, err error) error {
+	return withDetails(status.New(codes.InvalidArgument, field+": "+err.Error()), &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: err.Error()}},
+	})
+}
+
+// validateRecord validates a record written by a client. prefix is
+// prepended to the field names reported in the BadRequest detail.
+func validateRecord(prefix string, t pb.RRType, content string) error {
+	if err := validateType(t); err != nil {
+		return badRequest(prefix+"type", err)
+	}
+	if err := validateContent(t, content); err != nil {
+		return badRequest(prefix+"content", err)
+	}
+	return nil
+}
+
+// validateType rejects types which cannot be written by clients.
+func validateType(t pb.RRType) error {
+	if metaTypes[t] {
+		return fmt.Errorf("%s is a meta type", t)
+	}
+	if t == pb.RRType_SOA {
+		return errors.New("SOA is managed by the server")
+	}
+	return nil
+}
+
+// validateContent parses content according to t.
+func validateContent(t pb.RRType, content string) error {
+	if strings.TrimSpace(content) == "" {
+		return errors.New("content is empty")
+	}
+	f := strings.Fields(content)
+	switch t {
+	case pb.RRType_A:
+		ip := net.ParseIP(content)
+		if ip == nil || ip.To4() == nil || strings.Contains(content, ":") {
+			return fmt.Errorf("%q is not an IPv4 address", content)
+		}
+	case pb.RRType_AAAA:
+		ip := net.ParseIP(content)
+		if ip == nil || !strings.Contains(content, ":") {
+			return fmt.Errorf("%q is not an IPv6 address", content)
+		}
+	case pb.RRType_CNAME, pb.RRType_DNAME, pb.RRType_NS, pb.RRType_PTR,
+		pb.RRType_MB, pb.RRType_MD, pb.RRType_MF, pb.RRType_MG, pb.RRType_MR:
+		if len(f) != 1 {
+			return errors.New("expected a single domain name")
+		}
+		return validateName(f[0])
+	case pb.RRType_MX:
+		if len(f) != 2 {
+			return errors.New("expected <priority> <exchange>")
+		}
+		if err := validateUint("priority", f[0], 16); err != nil {
+			return err
+		}
+		return validateName(f[1])
+	case pb.RRType_SRV:
+		if len(f) != 4 {
+			return errors.New("expected <priority> <weight> <port> <target>")
+		}
+		for i, n := range []string{"priority", "weight", "port"} {
+			if err := validateUint(n, f[i], 16); err != nil {
+				return err
+			}
+		}
+		return validateName(f[3])
+	case pb.RRType_CAA:
+		if len(f) < 3 {
+			return errors.New("expected <flags> <tag> <value>")
+		}
+		if err := validateUint("flags", f[0], 8); err != nil {
+			return err
+		}
+		if !caaTag.MatchString(f[1]) {
+			return fmt.Errorf("tag %q must be alphanumeric", f[1])
+		}
+		v := strings.TrimSpace(strings.SplitN(strings.TrimSpace(content), f[1], 2)[1])
+		li, err := splitQuoted(v)
+		if err != nil {
+			return err
+		}
+		if len(li) != 1 {
+			return errors.New("value must be a single quoted string")
+		}
+	case pb.RRType_TXT, pb.RRType_SPF:
+		li, err := splitQuoted(content)
+		if err != nil {
+			return err
+		}
+		for _, s := range li {
+			if len(s) > 255 {
+				return errors.New("character-string is longer than 255 bytes")
+			}
+		}
+	case pb.RRType_TLSA, pb.RRType_SMIMEA:
+		if len(f) < 4 {
+			return errors.New("expected <usage> <selector> <matching type> <data>")
+		}
+		for i, n := range []string{"usage", "selector", "matching type"} {
+			if err := validateUint(n, f[i], 8); err != nil {
+				return err
+			}
+		}
+		return validateHex(strings.Join(f[3:], ""))
+	case pb.RRType_SSHFP:
+		if len(f) < 3 {
+			return errors.New("expected <algorithm> <fp type> <fingerprint>")
+		}
+		for i, n := range []string{"algorithm", "fp type"} {
+			if err := validateUint(n, f[i], 8); err != nil {
+				return err
+			}
+		}
+		return validateHex(strings.Join(f[2:], ""))
+	case pb.RRType_DS, pb.RRType_CDS, pb.RRType_DLV, pb.RRType_TA:
+		if len(f) < 4 {
+			return errors.New("expected <key tag> <algorithm> <digest type> <digest>")
+		}
+		if err := validateUint("key tag", f[0], 16); err != nil {
+			return err
+		}
+		for i, n := range []string{"algorithm", "digest type"} {
+			if err := validateUint(n, f[i+1], 8); err != nil {
+				return err
+			}
+		}
+		return validateHex(strings.Join(f[3:], ""))
+	default:
+		// Fall back to the dns package for the types it knows about.
+		ts := strings.Replace(t.String(), "_", "-", -1)
+		if _, ok := dns.StringToType[ts]; !ok {
+			return nil
+		}
+		if _, err := dns.NewRR(". 3600 IN " + ts + " " + content); err != nil {
+			msg := err.Error()
+			if i := strings.LastIndex(msg, " at line: "); i >= 0 {
+				msg = msg[:i]
+			}
+			return errors.New(strings.TrimPrefix(msg, "dns: "))
+		}
+	}
+	return nil
+}
+
+func validateName(s string) error {
+	if _, ok := dns.IsDomainName(s); !ok {
+		return fmt.Errorf("%q is not a domain name", s)
+	}
+	return nil
+}
+
+func validateUint(name string, s string, bits int) error {
+	if _, err := strconv.ParseUint(s, 10, bits); err != nil {
+		return fmt.Errorf("%s %q is not a %d bit unsigned integer", name, s, bits)
+	}
+	return nil
+}
+
+func validateHex(s string) error {
+	if _, err := hex.DecodeString(s); err != nil || s == "" {
+		return fmt.Errorf("%q is not hex encoded", s)
+	}
+	return nil
+}
+
+// splitQuoted splits a sequence of quoted character-strings and returns
+// them unquoted.
+func splitQuoted(s string) ([]string, error) {
+	li := make([]string, 0, 1)
+	s = strings.TrimSpace(s)
+	for s != "" {
+		if s[0] != '"' {
+			return nil, errors.New("character-strings must be quoted")
+		}
+		var b strings.Builder
+		i := 1
+		for ; i < len(s) && s[i] != '"'; i++ {
+			if s[i] == '\\' {
+				i++
+				if i == len(s) {
+					break
+				}
+			}
+			b.WriteByte(s[i])
+		}
+		if i >= len(s) {
+			return nil, errors.New("unterminated quoted string")
+		}
+		li = append(li, b.String())
+		rest := s[i+1:]
+		if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+			return nil, errors.New("quoted strings must be separated by spaces")
+		}
+		s = strings.TrimSpace(rest)
+	}
+	if len(li) == 0 {
+		return nil, errors.New("content is empty")
+	}
+	return li, nil
+}
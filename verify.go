@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// challengePrefix is prepended to the domain to get the name of the TXT
+// record holding the challenge.
+const challengePrefix = "_pdns-challenge."
+
+// Resolver looks up the records which prove ownership of a domain.
+type Resolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	LookupNS(ctx context.Context, name string) ([]string, error)
+}
+
+// netResolver resolves with the system resolver, or with the DNS server
+// at addr if it is set.
+type netResolver struct {
+	r *net.Resolver
+}
+
+func newNetResolver(addr string) Resolver {
+	if addr == "" {
+		return &netResolver{r: net.DefaultResolver}
+	}
+	return &netResolver{r: &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	}}
+}
+
+func (n *netResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	return n.r.LookupTXT(ctx, name)
+}
+
+func (n *netResolver) LookupNS(ctx context.Context, name string) ([]string, error) {
+	li, err := n.r.LookupNS(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	hosts := make([]string, 0, len(li))
+	for _, ns := range li {
+		hosts = append(hosts, ns.Host)
+	}
+	return hosts, nil
+}
+
+var resolver Resolver
+
+// GetResolver returns the resolver used to verify domains.
+func GetResolver() Resolver {
+	if resolver == nil {
+		resolver = newNetResolver(resolverAddr)
+	}
+	return resolver
+}
+
+// verifyDomain succeeds if the challenge is published in a TXT record or
+// the domain is delegated to nameserver.
+func verifyDomain(ctx context.Context, r Resolver, domain string, challenge string) error {
+	txt, _ := r.LookupTXT(ctx, challengePrefix+domain)
+	for _, v := range txt {
+		if v == challenge {
+			return nil
+		}
+	}
+	if nameserver == "" {
+		return errors.New("the TXT challenge was not found")
+	}
+	ns, _ := r.LookupNS(ctx, domain)
+	for _, v := range ns {
+		if strings.EqualFold(strings.TrimSuffix(v, "."), strings.TrimSuffix(nameserver, ".")) {
+			return nil
+		}
+	}
+	return errors.New("neither the TXT challenge nor the NS delegation was found")
+}
+
+// claimDomain returns the pending claim of account on domain, creating a
+// new challenge if there is none or it has expired.
+func claimDomain(ctx context.Context, tx *sql.Tx, domain string, account string, org int64) (*pb.PendingDomain, error) {
+	if org != 0 {
+		if _, err := requireRole(ctx, tx, org, account, pb.Role_Admin); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkZoneLimit(ctx, tx, account); err != nil {
+		return nil, err
+	}
+	p := &pb.PendingDomain{Name: domain, TxtName: challengePrefix + domain, Nameserver: nameserver}
+	var expires time.Time
+	err := tx.QueryRowContext(ctx, "SELECT challenge, expires_at FROM pending_domains WHERE name = $1 AND account = $2 AND expires_at > now();", domain, account).Scan(&p.Challenge, &expires)
+	if err == nil {
+		p.ExpiresAt = expires.Unix()
+		return p, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+	p.Challenge, err = randomString(24)
+	if err != nil {
+		return nil, err
+	}
+	expires = time.Now().Add(verificationTTL)
+	var o sql.NullInt64
+	if org != 0 {
+		o = sql.NullInt64{Int64: org, Valid: true}
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM pending_domains WHERE name = $1 AND account = $2;", domain, account)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO pending_domains(name,account,organization,challenge,expires_at) VALUES ($1,$2,$3,$4,$5);", domain, account, o, p.Challenge, expires)
+	if err != nil {
+		return nil, err
+	}
+	p.ExpiresAt = expires.Unix()
+	return p, nil
+}
+
+// VerifyZone creates a pending zone once the challenge is found. Other
+// claims on the same domain are dropped. The DNS is queried outside the
+// transaction, and the claim is read again before the zone is created.
+func (s *server) VerifyZone(ctx context.Context, in *pb.VerifyZoneRequest) (*pb.VerifyZoneResponse, error) {
+	domain := in.GetDomain()
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	var challenge string
+	err = tx.QueryRowContext(ctx, "SELECT challenge FROM pending_domains WHERE name = $1 AND account = $2 AND expires_at > now();", domain, a).Scan(&challenge)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return nil, notFound("pending domain", domain)
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyDomain(ctx, GetResolver(), domain, challenge); err != nil {
+		return nil, failedPrecondition("domain", err.Error())
+	}
+
+	tx, err = GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	var org sql.NullInt64
+	err = tx.QueryRowContext(ctx, "SELECT organization FROM pending_domains WHERE name = $1 AND account = $2 AND challenge = $3 AND expires_at > now();", domain, a, challenge).Scan(&org)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return nil, notFound("pending domain", domain)
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = authorizeDomain(ctx, tx, domain, a, pb.Role_Viewer)
+	if status.Code(err) != codes.NotFound {
+		tx.Rollback()
+		return nil, alreadyExists("domain", domain, "this domain is already used by other user")
+	}
+	if err := checkZoneLimit(ctx, tx, a); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = createZone(ctx, tx, domain, a, org.Int64)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM pending_domains WHERE name = $1;", domain)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.VerifyZoneResponse{Status: pb.ResponseStatus_Ok}, nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubResolver answers from maps instead of the DNS.
+type stubResolver struct {
+	txt map[string][]string
+	ns  map[string][]string
+}
+
+func (r *stubResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	if li, ok := r.txt[name]; ok {
+		return li, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+func (r *stubResolver) LookupNS(ctx context.Context, name string) ([]string, error) {
+	if li, ok := r.ns[name]; ok {
+		return li, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+func TestVerifyDomain(t *testing.T) {
+	old := nameserver
+	defer func() { nameserver = old }()
+	nameserver = "ns.example.com"
+	r := &stubResolver{
+		txt: map[string][]string{
+			"_pdns-challenge.txt.example": {"other", "secret"},
+			"_pdns-challenge.bad.example": {"other"},
+		},
+		ns: map[string][]string{
+			"ns.example":  {"ns1.other.net.", "NS.example.com."},
+			"bad.example": {"ns1.other.net."},
+		},
+	}
+	ctx := context.Background()
+	assert.Equal(t, nil, verifyDomain(ctx, r, "txt.example", "secret"))
+	assert.Equal(t, nil, verifyDomain(ctx, r, "ns.example", "secret"))
+	assert.NotEqual(t, nil, verifyDomain(ctx, r, "bad.example", "secret"))
+	assert.NotEqual(t, nil, verifyDomain(ctx, r, "missing.example", "secret"))
+}
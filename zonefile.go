@@ -0,0 +1,479 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/KoyamaSohei/special-seminar-api/proto"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/miekg/dns"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// zoneError is a parse error on a line of a master file.
+type zoneError struct {
+	line int
+	msg  string
+}
+
+func lineError(line int, msg string) *zoneError {
+	return &zoneError{line: line, msg: msg}
+}
+
+// zoneEntry is one logical entry of a master file, which may span
+// several physical lines when parentheses are used.
+type zoneEntry struct {
+	line int
+	text string
+}
+
+// splitZone splits a master file into logical entries. Comments and
+// quoted strings are taken into account when looking for parentheses.
+// Comments are dropped, and so are entries left blank without them.
+func splitZone(zone string) ([]zoneEntry, []*zoneError) {
+	var (
+		entries []zoneEntry
+		errs    []*zoneError
+		buf     strings.Builder
+		line    = 1
+		start   = 1
+		depth   = 0
+		quoted  = false
+		escaped = false
+		comment = false
+	)
+	flush := func() {
+		if strings.TrimSpace(buf.String()) != "" {
+			entries = append(entries, zoneEntry{line: start, text: buf.String()})
+		}
+		buf.Reset()
+	}
+	for _, c := range zone {
+		switch {
+		case c == '\n':
+			comment = false
+			line++
+			if depth == 0 && !quoted {
+				flush()
+				start = line
+				continue
+			}
+		case comment:
+			continue
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == ';':
+			comment = true
+			continue
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				errs = append(errs, lineError(line, "unbalanced parenthesis"))
+				depth = 0
+			}
+		}
+		buf.WriteRune(c)
+	}
+	if depth != 0 || quoted {
+		errs = append(errs, lineError(start, "unterminated entry"))
+		buf.Reset()
+	}
+	flush()
+	return entries, errs
+}
+
+// parseZone parses a master file for the given domain. Every entry is
+// parsed on its own so that all broken lines are reported at once.
+func parseZone(domain string, zone string) ([]*pb.Record, []*zoneError) {
+	entries, errs := splitZone(zone)
+	apex := dns.Fqdn(strings.ToLower(domain))
+	origin := apex
+	ttl := uint32(defTTL)
+	owner := ""
+	li := make([]*pb.Record, 0, len(entries))
+	for _, e := range entries {
+		fields := strings.Fields(e.text)
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) < 2 {
+				errs = append(errs, lineError(e.line, "$ORIGIN requires a domain name"))
+				continue
+			}
+			o := fields[1]
+			if !dns.IsFqdn(o) {
+				o = dns.Fqdn(o + "." + origin)
+			}
+			if _, ok := dns.IsDomainName(o); !ok {
+				errs = append(errs, lineError(e.line, "bad $ORIGIN name"))
+				continue
+			}
+			origin = strings.ToLower(o)
+			continue
+		case "$TTL":
+			if len(fields) < 2 {
+				errs = append(errs, lineError(e.line, "$TTL requires a value"))
+				continue
+			}
+			t, ok := parseTTL(fields[1])
+			if !ok {
+				errs = append(errs, lineError(e.line, "bad $TTL value"))
+				continue
+			}
+			ttl = t
+			continue
+		case "$INCLUDE":
+			errs = append(errs, lineError(e.line, "$INCLUDE is not supported"))
+			continue
+		}
+
+		text := e.text
+		if text[0] == ' ' || text[0] == '\t' {
+			if owner == "" {
+				errs = append(errs, lineError(e.line, "no previous owner name"))
+				continue
+			}
+			text = owner + text
+		}
+		zp := dns.NewZoneParser(strings.NewReader(text), origin, "")
+		zp.SetDefaultTTL(ttl)
+		for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+			h := rr.Header()
+			owner = h.Name
+			r, msg := toRecord(apex, rr)
+			if msg != "" {
+				errs = append(errs, lineError(e.line, msg))
+				continue
+			}
+			if r.GetType() != pb.RRType_SOA {
+				if err := validateType(r.GetType()); err != nil {
+					errs = append(errs, lineError(e.line, err.Error()))
+					continue
+				}
+			}
+			if err := validateContent(r.GetType(), r.GetContent()); err != nil {
+				errs = append(errs, lineError(e.line, err.Error()))
+				continue
+			}
+			li = append(li, r)
+		}
+		if err := zp.Err(); err != nil {
+			errs = append(errs, toParseError(e.line, err))
+		}
+	}
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].line < errs[j].line })
+	return li, errs
+}
+
+// parseTTL parses a TTL value, accepting BIND style units like 1h30m.
+func parseTTL(s string) (uint32, bool) {
+	var ttl, n uint64
+	digits := false
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			n = n*10 + uint64(c-'0')
+			digits = true
+			continue
+		}
+		if !digits {
+			return 0, false
+		}
+		switch c {
+		case 's':
+		case 'm':
+			n *= 60
+		case 'h':
+			n *= 60 * 60
+		case 'd':
+			n *= 60 * 60 * 24
+		case 'w':
+			n *= 60 * 60 * 24 * 7
+		default:
+			return 0, false
+		}
+		ttl += n
+		n = 0
+		digits = false
+	}
+	ttl += n
+	if s == "" || ttl > 1<<31-1 {
+		return 0, false
+	}
+	return uint32(ttl), true
+}
+
+// toRecord converts a parsed RR into the Record shape stored in
+// PowerDNS, with names lowercased and without the trailing dot.
+func toRecord(apex string, rr dns.RR) (*pb.Record, string) {
+	h := rr.Header()
+	name := strings.ToLower(h.Name)
+	if !dns.IsSubDomain(apex, name) {
+		return nil, "name " + h.Name + " is out of zone"
+	}
+	if h.Class != dns.ClassINET {
+		return nil, "class " + dns.Class(h.Class).String() + " is not supported"
+	}
+	ts := strings.Replace(dns.Type(h.Rrtype).String(), "-", "_", -1)
+	t, ok := pb.RRType_value[ts]
+	if !ok {
+		return nil, "type " + ts + " is not supported"
+	}
+	return &pb.Record{
+		Name:    strings.TrimSuffix(name, "."),
+		Type:    pb.RRType(t),
+		Ttl:     int64(h.Ttl),
+		Content: toContent(rr),
+	}, ""
+}
+
+// toContent renders the rdata of rr the way PowerDNS stores it, that is
+// without the trailing dot on embedded domain names.
+func toContent(rr dns.RR) string {
+	n := func(s string) string {
+		if s == "." {
+			return s
+		}
+		return strings.TrimSuffix(s, ".")
+	}
+	switch v := rr.(type) {
+	case *dns.NS:
+		return n(v.Ns)
+	case *dns.CNAME:
+		return n(v.Target)
+	case *dns.DNAME:
+		return n(v.Target)
+	case *dns.PTR:
+		return n(v.Ptr)
+	case *dns.MX:
+		return fmt.Sprintf("%d %s", v.Preference, n(v.Mx))
+	case *dns.SRV:
+		return fmt.Sprintf("%d %d %d %s", v.Priority, v.Weight, v.Port, n(v.Target))
+	case *dns.SOA:
+		return fmt.Sprintf("%s %s %d %d %d %d %d", n(v.Ns), n(v.Mbox), v.Serial, v.Refresh, v.Retry, v.Expire, v.Minttl)
+	}
+	return strings.TrimSpace(strings.TrimPrefix(rr.String(), rr.Header().String()))
+}
+
+// toParseError rebases the line number reported by the dns package,
+// which counts from the start of the entry, onto the whole file.
+func toParseError(line int, err error) *zoneError {
+	msg := err.Error()
+	i := strings.LastIndex(msg, " at line: ")
+	if i < 0 {
+		return lineError(line, msg)
+	}
+	pos := strings.SplitN(msg[i+len(" at line: "):], ":", 2)
+	if n, err := strconv.Atoi(pos[0]); err == nil && n > 0 {
+		line += n - 1
+	}
+	return lineError(line, strings.TrimPrefix(msg[:i], "dns: "))
+}
+
+func (s *server) ImportZone(ctx context.Context, in *pb.ImportZoneRequest) (*pb.ImportZoneResponse, error) {
+	domain := strings.TrimSuffix(strings.ToLower(in.GetDomain()), ".")
+	if domain == "" {
+		return nil, badRequest("domain", errors.New("domain is required"))
+	}
+	li, errs := parseZone(domain, in.GetZone())
+	if len(errs) != 0 {
+		br := &errdetails.BadRequest{}
+		for _, e := range errs {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "zone",
+				Description: fmt.Sprintf("line %d: %s", e.line, e.msg),
+			})
+		}
+		return nil, withDetails(status.New(codes.InvalidArgument, fmt.Sprintf("zone has %d errors", len(errs))), br)
+	}
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	id, err := initZone(ctx, tx, domain, a, in.GetOrganization())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	// SOA is maintained by this service, and the NS created by initZone
+	// must not be inserted twice.
+	seen := map[string]bool{
+		domain + " NS " + target: true,
+	}
+	for _, r := range li {
+		if r.GetType() == pb.RRType_SOA {
+			continue
+		}
+		k := r.GetName() + " " + r.GetType().String() + " " + r.GetContent()
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		err = addRecord(ctx, tx, id, r.GetName(), r.GetType(), r.GetContent(), r.GetTtl())
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	err = updateSoa(ctx, tx, domain, a)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return &pb.ImportZoneResponse{Status: pb.ResponseStatus_Ok}, nil
+}
+
+// getZone returns every record of the zone, SOA included, in the order
+// they are written to a master file.
+func getZone(ctx context.Context, tx *sql.Tx, id string, origin string) ([]*pb.Record, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT name,type,content,COALESCE(ttl,$2) FROM records WHERE domain_id = $1;", id, defTTL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	li := make([]*pb.Record, 0, 10)
+	for rows.Next() {
+		item := new(pb.Record)
+		var t string
+		err := rows.Scan(&item.Name, &t, &item.Content, &item.Ttl)
+		if err != nil {
+			return nil, err
+		}
+		item.Type = (pb.RRType)(pb.RRType_value[t])
+		li = append(li, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rank := func(r *pb.Record) int {
+		switch {
+		case r.GetType() == pb.RRType_SOA:
+			return 0
+		case r.GetType() == pb.RRType_NS && r.GetName() == origin:
+			return 1
+		}
+		return 2
+	}
+	sort.SliceStable(li, func(i, j int) bool {
+		a, b := li[i], li[j]
+		if rank(a) != rank(b) {
+			return rank(a) < rank(b)
+		}
+		if a.GetName() != b.GetName() {
+			return a.GetName() < b.GetName()
+		}
+		if a.GetType() != b.GetType() {
+			return a.GetType().String() < b.GetType().String()
+		}
+		return a.GetContent() < b.GetContent()
+	})
+	return li, nil
+}
+
+// renderZone writes records as a master file relative to origin.
+func renderZone(origin string, li []*pb.Record) string {
+	apex := dns.Fqdn(origin)
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s\n$TTL %d\n", apex, defTTL)
+	for _, r := range li {
+		name := dns.Fqdn(r.GetName())
+		owner := "@"
+		if name != apex {
+			owner = strings.TrimSuffix(name, "."+apex)
+		}
+		t := strings.Replace(r.GetType().String(), "_", "-", -1)
+		content := r.GetContent()
+		rr, err := dns.NewRR(fmt.Sprintf("%s %d IN %s %s", name, r.GetTtl(), t, content))
+		if err == nil && rr != nil {
+			content = strings.TrimSpace(strings.TrimPrefix(rr.String(), rr.Header().String()))
+		}
+		fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s\n", owner, r.GetTtl(), t, content)
+	}
+	return b.String()
+}
+
+// renderJSON writes records as a JSON array of Record.
+func renderJSON(li []*pb.Record) (string, error) {
+	m := jsonpb.Marshaler{EmitDefaults: true}
+	var b bytes.Buffer
+	b.WriteString("[")
+	for i, r := range li {
+		if i != 0 {
+			b.WriteString(",")
+		}
+		err := m.Marshal(&b, r)
+		if err != nil {
+			return "", err
+		}
+	}
+	b.WriteString("]")
+	return b.String(), nil
+}
+
+func (s *server) ExportZone(ctx context.Context, in *pb.ExportZoneRequest) (*pb.ExportZoneResponse, error) {
+	tx, err := GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	a, err := getAccountID(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	o := in.GetOrigin()
+	access, err := authorizeZone(ctx, tx, o, a, pb.Role_Viewer)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	all, err := getZone(ctx, tx, access.ID, o)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	li := make([]*pb.Record, 0, len(all))
+	for _, r := range all {
+		// Limited shares still export the SOA so that the result is a zone.
+		if r.GetType() == pb.RRType_SOA || access.allows(r.GetName(), r.GetType()) {
+			li = append(li, r)
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	var z string
+	switch in.GetFormat() {
+	case pb.ExportZoneRequest_Json:
+		z, err = renderJSON(li)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		z = renderZone(o, li)
+	}
+	return &pb.ExportZoneResponse{Status: pb.ResponseStatus_Ok, Zone: z}, nil
+}
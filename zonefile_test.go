@@ -0,0 +1,22 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseZoneLeadingComment(t *testing.T) {
+	zone := "  ; leading comment\n\t\n@ 3600 IN A 192.0.2.1 ; trailing comment\n  3600 IN AAAA 2001:db8::1\n"
+	li, errs := parseZone("example.com", zone)
+	assert.Equal(t, 0, len(errs))
+	if assert.Equal(t, 2, len(li)) {
+		assert.Equal(t, "192.0.2.1", li[0].GetContent())
+		assert.Equal(t, "example.com", li[1].GetName())
+	}
+
+	_, errs = parseZone("example.com", "  ; comment\n  3600 IN A 192.0.2.1\n")
+	if assert.Equal(t, 1, len(errs)) {
+		assert.Equal(t, 2, errs[0].line)
+	}
+}